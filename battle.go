@@ -0,0 +1,482 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// BattlePhase is the part of the lifecycle of a battle a BattleEvent is about.
+type BattlePhase string
+
+const (
+	BATTLE_STARTED         BattlePhase = "battle started"
+	BATTLE_SCORE           BattlePhase = "battle score updated"
+	BATTLE_TASK            BattlePhase = "battle task updated"
+	BATTLE_ENDED           BattlePhase = "battle ended, punishment started"
+	BATTLE_PUNISH_FINISHED BattlePhase = "battle punishment finished"
+)
+
+// BattleEvent follows a battle from its start, through the score updates, to its end, when the punishment of the loser
+// starts, and the end of the punishment. BattleState holds the full state of the battle after the event, Live.Battles
+// returns the battles that did not finish yet. BattleEvent is sent along with MicBattleEvent and BattlesEvent.
+type BattleEvent struct {
+	MessageID int64
+	Timestamp int64
+	Phase     BattlePhase
+	Battle    *BattleState
+	isHistory bool
+}
+
+func (b BattleEvent) IsHistory() bool {
+	return b.isHistory
+}
+
+func (b BattleEvent) CreatedTimestamp() int64 {
+	return b.Timestamp
+}
+
+// BattleState is a battle between hosts, one against one or two against two.
+type BattleState struct {
+	ID        int64
+	Phase     BattlePhase
+	StartedAt time.Time
+	// Teams has one team per host in a 1v1 battle and two teams of two hosts in a 2v2 battle.
+	Teams []*BattleTeam
+	// Task is the last battle task update, nil without tasks.
+	Task *BattleTask
+}
+
+// Winner returns the team with the most points, nil on a tie.
+func (b BattleState) Winner() *BattleTeam {
+	var winner *BattleTeam
+	tie := false
+	for _, t := range b.Teams {
+		switch {
+		case winner == nil || t.Points > winner.Points:
+			winner, tie = t, false
+		case t.Points == winner.Points:
+			tie = true
+		}
+	}
+	if tie {
+		return nil
+	}
+	return winner
+}
+
+// BattleTeam is a side of a battle. ID is the host id in a 1v1 battle and the team number in a 2v2 battle.
+type BattleTeam struct {
+	ID         string
+	Hosts      []*BattleHost
+	Points     int
+	WinStreak  int
+	TopViewers []*BattleViewer
+}
+
+// BattleHost is a host in a battle with the points of their side of the team.
+type BattleHost struct {
+	User   *User
+	Points int
+}
+
+// BattleViewer is a top contributor of a team.
+type BattleViewer struct {
+	User   *User
+	Points int
+}
+
+// BattleTask is a battle task, a challenge given to the hosts during a battle. TikTok does not label the values of a
+// task and their meaning is unknown, so they are named after the WebcastLinkmicBattleTaskMessage fields they come from.
+type BattleTask struct {
+	// Data2 is Data2 of the message.
+	Data2 int
+	// Data3 is Data3.Data1.Data1 of the message.
+	Data3 int
+	// Data5 are Data5.Data1 and Data5.Data2 of the message.
+	Data5 [2]int
+}
+
+func toBattleTaskEvent(pt *pb.WebcastLinkmicBattleTaskMessage, isHistory bool) BattleEvent {
+	return BattleEvent{
+		MessageID: pt.Header.GetMsgId(),
+		Timestamp: pt.Header.GetCreateTime(),
+		Phase:     BATTLE_TASK,
+		Battle: &BattleState{Phase: BATTLE_TASK, Task: &BattleTask{
+			Data2: int(pt.Data2),
+			Data3: int(pt.GetData3().GetData1().GetData1()),
+			Data5: [2]int{int(pt.GetData5().GetData1()), int(pt.GetData5().GetData2())},
+		}},
+		isHistory: isHistory,
+	}
+}
+
+func toBattlePunishFinishEvent(pt *pb.WebcastLinkMicBattlePunishFinish, isHistory bool) BattleEvent {
+	return BattleEvent{
+		MessageID: pt.Header.GetMsgId(),
+		Timestamp: pt.Header.GetCreateTime(),
+		Phase:     BATTLE_PUNISH_FINISHED,
+		Battle:    &BattleState{ID: int64(pt.Id1), Phase: BATTLE_PUNISH_FINISHED},
+		isHistory: isHistory,
+	}
+}
+
+// battleUpdate is what the battle tracker reads from a WebcastLinkMicBattle or WebcastLinkMicArmies message. It is
+// built by parseMsg so the events do not hold on to the messages.
+type battleUpdate struct {
+	finished  bool
+	startedAt time.Time
+	// teams are the numbered teams of a 2v2 battle with the points of their hosts.
+	teams []battleTeamUpdate
+	// groups are the hosts of the battle by the id of their team in a 1v1 battle.
+	groups  []battleGroupUpdate
+	details []battleHostPoints
+	streaks []battleWinStreak
+	viewers []battleViewersUpdate
+	// armies are the points of every host of an armies message.
+	armies []battleHostPoints
+}
+
+type battleTeamUpdate struct {
+	id     string
+	points int
+	hosts  []battleHostPoints
+}
+
+type battleGroupUpdate struct {
+	id     string
+	points int
+	users  []*User
+}
+
+type battleHostPoints struct {
+	id     int64
+	points int
+}
+
+type battleWinStreak struct {
+	teamID uint64
+	// known is false when the team data has no win streak.
+	known     bool
+	winStreak int
+}
+
+type battleViewersUpdate struct {
+	team    string
+	viewers []*BattleViewer
+}
+
+func battleFinished(status pb.LinkMicBattleStatus) bool {
+	return status == pb.LinkMicBattleStatus_BATTLE_FINISHED || status == pb.LinkMicBattleStatus_ARMY_FINISHED
+}
+
+func toMicBattleUpdate(pt *pb.WebcastLinkMicBattle) *battleUpdate {
+	u := &battleUpdate{finished: battleFinished(pt.BattleStatus)}
+	if ts := pt.GetBattleConfig().GetTimestamp(); ts != 0 {
+		u.startedAt = unixTime(ts, 0)
+	}
+	for _, d := range pt.HostData2V2 {
+		team := battleTeamUpdate{id: strconv.FormatUint(uint64(d.TeamNumber), 10), points: int(d.TotalPoints)}
+		for _, h := range d.Hostdata {
+			team.hosts = append(team.hosts, battleHostPoints{id: int64(h.HostId), points: int(h.Points)})
+		}
+		u.teams = append(u.teams, team)
+	}
+	for _, team := range pt.HostTeam {
+		for _, g := range team.HostGroup {
+			group := battleGroupUpdate{id: g.HostId, points: int(g.Points)}
+			for _, h := range g.Host {
+				group.users = append(group.users, battleHostUser(h))
+			}
+			u.groups = append(u.groups, group)
+		}
+	}
+	for _, d := range pt.Details {
+		if d.Summary != nil {
+			u.details = append(u.details, battleHostPoints{id: int64(d.Id), points: int(d.Summary.Points)})
+		}
+	}
+	for _, td := range pt.TeamData {
+		streak := battleWinStreak{teamID: td.TeamId}
+		if td.Data != nil {
+			streak.known = true
+			streak.winStreak = int(td.Data.WinStreak)
+		}
+		u.streaks = append(u.streaks, streak)
+	}
+	for _, v := range pt.ViewerTeam {
+		for _, g := range v.ViewerGroup {
+			viewers := battleViewersUpdate{team: g.HostIdOrTeamNum}
+			for _, viewer := range g.Viewer {
+				user := &User{ID: int64(viewer.Id), Username: viewer.ProfileId, Nickname: viewer.ProfileId}
+				for _, img := range viewer.Images {
+					if img != nil && len(img.UrlList) > 0 {
+						user.ProfilePicture = &ProfilePicture{Urls: img.UrlList}
+						break
+					}
+				}
+				viewers.viewers = append(viewers.viewers, &BattleViewer{User: user, Points: int(viewer.Points)})
+			}
+			u.viewers = append(u.viewers, viewers)
+		}
+	}
+	return u
+}
+
+// toArmiesUpdate sums the points of the groups of every host.
+func toArmiesUpdate(pt *pb.WebcastLinkMicArmies) *battleUpdate {
+	u := &battleUpdate{finished: battleFinished(pt.BattleStatus)}
+	for _, item := range pt.BattleItems {
+		points := 0
+		for _, g := range item.BattleGroups {
+			points += int(g.Points)
+		}
+		u.armies = append(u.armies, battleHostPoints{id: int64(item.HostUserId), points: points})
+	}
+	return u
+}
+
+// battleTracker keeps the battles of a live by battle id.
+type battleTracker struct {
+	mu      sync.Mutex
+	battles map[int64]*BattleState
+	// current is the id of the last battle seen, for the task and punishment finished messages without a battle id.
+	current int64
+}
+
+// trackBattle updates the battle of a battle message and returns the message followed by a BattleEvent with the state
+// of the battle.
+func (l *Live) trackBattle(e Event) []Event {
+	tr := &l.battles
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.battles == nil {
+		tr.battles = make(map[int64]*BattleState)
+	}
+
+	switch e := e.(type) {
+	case MicBattleEvent:
+		if e.battle == nil || e.BattleID == 0 {
+			return []Event{e}
+		}
+		b, phase := tr.battle(e.BattleID, e.battle.finished)
+		e.battle.apply(b)
+		return []Event{e, tr.event(e.MessageID, e.Timestamp, e.isHistory, b, phase)}
+	case BattlesEvent:
+		if e.battle == nil || e.BattleID == 0 {
+			return []Event{e}
+		}
+		b, phase := tr.battle(e.BattleID, e.battle.finished)
+		e.battle.apply(b)
+		return []Event{e, tr.event(e.MessageID, e.Timestamp, e.isHistory, b, phase)}
+	case BattleEvent:
+		id := e.Battle.ID
+		if id == 0 {
+			id = tr.current
+		}
+		b, ok := tr.battles[id]
+		if !ok {
+			return []Event{e}
+		}
+		if e.Phase == BATTLE_TASK {
+			b.Task = e.Battle.Task
+		} else {
+			b.Phase = e.Phase
+			delete(tr.battles, b.ID)
+		}
+		return []Event{tr.event(e.MessageID, e.Timestamp, e.isHistory, b, e.Phase)}
+	}
+	return []Event{e}
+}
+
+// battle returns the battle with the id, creating it when new, and the phase of the battle after a message that
+// finished it or not.
+func (tr *battleTracker) battle(id int64, finished bool) (*BattleState, BattlePhase) {
+	b, ok := tr.battles[id]
+	if !ok {
+		// Punishments that were never reported as finished end with the next battle.
+		for other, known := range tr.battles {
+			if known.Phase == BATTLE_ENDED {
+				delete(tr.battles, other)
+			}
+		}
+		b = &BattleState{ID: id, Phase: BATTLE_STARTED}
+		tr.battles[id] = b
+		tr.current = id
+		if finished {
+			b.Phase = BATTLE_ENDED
+		}
+		return b, b.Phase
+	}
+	if finished && b.Phase != BATTLE_ENDED {
+		b.Phase = BATTLE_ENDED
+		return b, BATTLE_ENDED
+	}
+	if b.Phase == BATTLE_ENDED {
+		return b, BATTLE_ENDED
+	}
+	b.Phase = BATTLE_SCORE
+	return b, BATTLE_SCORE
+}
+
+func (tr *battleTracker) event(msgID, timestamp int64, isHistory bool, b *BattleState, phase BattlePhase) BattleEvent {
+	return BattleEvent{
+		MessageID: msgID,
+		Timestamp: timestamp,
+		Phase:     phase,
+		Battle:    b.clone(),
+		isHistory: isHistory,
+	}
+}
+
+// team returns the team with the id, adding it when new.
+func (b *BattleState) team(id string) *BattleTeam {
+	for _, t := range b.Teams {
+		if t.ID == id {
+			return t
+		}
+	}
+	t := &BattleTeam{ID: id}
+	b.Teams = append(b.Teams, t)
+	return t
+}
+
+// host returns the host with the user id and its team, nil when the host is not known.
+func (b *BattleState) host(userID int64) (*BattleTeam, *BattleHost) {
+	for _, t := range b.Teams {
+		for _, h := range t.Hosts {
+			if h.User.ID == userID {
+				return t, h
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (t *BattleTeam) host(user *User) *BattleHost {
+	for _, h := range t.Hosts {
+		if h.User.ID == user.ID {
+			// Some messages only have the host id, keep the user from the others.
+			if user.Nickname != "" || user.Username != "" {
+				h.User = user
+			}
+			return h
+		}
+	}
+	h := &BattleHost{User: user}
+	t.Hosts = append(t.Hosts, h)
+	return h
+}
+
+// apply updates the battle from the message, the points of every host of an armies message are added up per team.
+func (u *battleUpdate) apply(b *BattleState) {
+	if !u.startedAt.IsZero() && b.StartedAt.IsZero() {
+		b.StartedAt = u.startedAt
+	}
+	// 2v2 battles group the hosts in numbered teams, 1v1 battles have a team per host.
+	for _, d := range u.teams {
+		t := b.team(d.id)
+		for _, h := range d.hosts {
+			t.host(&User{ID: h.id}).Points = h.points
+		}
+		t.Points = d.points
+	}
+	for _, g := range u.groups {
+		for _, user := range g.users {
+			t, _ := b.host(user.ID)
+			if t == nil {
+				id := g.id
+				if id == "" {
+					id = strconv.FormatInt(user.ID, 10)
+				}
+				t = b.team(id)
+			}
+			t.host(user)
+		}
+		if len(u.teams) == 0 && g.id != "" {
+			b.team(g.id).Points = g.points
+		}
+	}
+	for _, d := range u.details {
+		if t, h := b.host(d.id); t != nil {
+			h.Points = d.points
+			if len(u.teams) == 0 {
+				t.Points = h.Points
+			}
+		}
+	}
+	for _, s := range u.streaks {
+		t, _ := b.host(int64(s.teamID))
+		if t == nil {
+			t = b.team(strconv.FormatUint(s.teamID, 10))
+		}
+		if s.known {
+			t.WinStreak = s.winStreak
+		}
+	}
+	for _, v := range u.viewers {
+		t := b.team(v.team)
+		t.TopViewers = append(t.TopViewers[:0], v.viewers...)
+	}
+	for _, a := range u.armies {
+		t, h := b.host(a.id)
+		if t == nil {
+			t = b.team(strconv.FormatInt(a.id, 10))
+			h = t.host(&User{ID: a.id})
+		}
+		h.Points = a.points
+		t.Points = 0
+		for _, h := range t.Hosts {
+			t.Points += h.Points
+		}
+	}
+}
+
+func battleHostUser(h *pb.WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) *User {
+	user := &User{ID: int64(h.Id), Username: h.ProfileId, Nickname: h.Name}
+	for _, img := range h.Images {
+		if img != nil && len(img.UrlList) > 0 {
+			user.ProfilePicture = &ProfilePicture{Urls: img.UrlList}
+			break
+		}
+	}
+	return user
+}
+
+func (b *BattleState) clone() *BattleState {
+	c := *b
+	c.Teams = make([]*BattleTeam, len(b.Teams))
+	for i, t := range b.Teams {
+		team := *t
+		team.Hosts = make([]*BattleHost, len(t.Hosts))
+		for j, h := range t.Hosts {
+			host := *h
+			team.Hosts[j] = &host
+		}
+		team.TopViewers = append([]*BattleViewer(nil), t.TopViewers...)
+		c.Teams[i] = &team
+	}
+	if b.Task != nil {
+		task := *b.Task
+		c.Task = &task
+	}
+	return &c
+}
+
+// Battles returns the battles of the live that did not finish their punishment yet, ordered by id.
+func (l *Live) Battles() []BattleState {
+	tr := &l.battles
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	battles := make([]BattleState, 0, len(tr.battles))
+	for _, b := range tr.battles {
+		battles = append(battles, *b.clone())
+	}
+	sort.Slice(battles, func(i, j int) bool {
+		return battles[i].ID < battles[j].ID
+	})
+	return battles
+}
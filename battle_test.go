@@ -0,0 +1,125 @@
+package gotiktoklive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func micBattleMessage(msgID int64, status pb.LinkMicBattleStatus) *pb.WebcastLinkMicBattle {
+	host := func(id uint64, name string) *pb.WebcastLinkMicBattle_LinkMicBattleHost {
+		return &pb.WebcastLinkMicBattle_LinkMicBattleHost{Id: id, HostGroup: []*pb.WebcastLinkMicBattle_LinkMicBattleHost_HostGroup{{
+			Host:   []*pb.WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host{{Id: id, Name: name}},
+			HostId: name,
+		}}}
+	}
+	return &pb.WebcastLinkMicBattle{
+		Common:       &pb.Common{Method: "WebcastLinkMicBattle", MsgId: msgID, CreateTime: 1000},
+		Id:           77,
+		BattleStatus: status,
+		HostTeam:     []*pb.WebcastLinkMicBattle_LinkMicBattleHost{host(1, "1"), host(2, "2")},
+		TeamData: []*pb.WebcastLinkMicBattle_LinkMicBattleTeamData{
+			{TeamId: 1, Data: &pb.WebcastLinkMicBattle_LinkMicBattleData{WinStreak: 3}},
+		},
+		ViewerTeam: []*pb.WebcastLinkMicBattle_LinkMicBattleTopViewers{{ViewerGroup: []*pb.WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup{{
+			HostIdOrTeamNum: "2",
+			Viewer:          []*pb.WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer{{Id: 9, Points: 50, ProfileId: "fan"}},
+		}}}},
+	}
+}
+
+func armiesMessage(msgID int64, status pb.LinkMicBattleStatus, points1, points2 uint32) *pb.WebcastLinkMicArmies {
+	return &pb.WebcastLinkMicArmies{
+		Common:       &pb.Common{Method: "WebcastLinkMicArmies", MsgId: msgID, CreateTime: 1000},
+		Id:           77,
+		BattleStatus: status,
+		BattleItems: []*pb.LinkMicArmiesItems{
+			{HostUserId: 1, BattleGroups: []*pb.LinkMicArmiesItems_LinkMicArmiesGroup{{Points: points1}}},
+			{HostUserId: 2, BattleGroups: []*pb.LinkMicArmiesItems_LinkMicArmiesGroup{{Points: points2}}},
+		},
+	}
+}
+
+// trackBattleMsg parses and tracks m, returning the BattleEvent it results in.
+func trackBattleMsg(t *testing.T, live *Live, m proto.Message) BattleEvent {
+	t.Helper()
+	events := live.track(parseTestMsg(t, m))
+	if !assert.NotEmpty(t, events) {
+		t.FailNow()
+	}
+	e, ok := events[len(events)-1].(BattleEvent)
+	if !ok {
+		t.Fatalf("got %T, want BattleEvent", events[len(events)-1])
+	}
+	return e
+}
+
+func TestBattleLifecycle(t *testing.T) {
+	live := &Live{}
+
+	started := trackBattleMsg(t, live, micBattleMessage(1, pb.LinkMicBattleStatus_BATTLE_ONGOING))
+	assert.Equal(t, BATTLE_STARTED, started.Phase)
+	assert.Equal(t, int64(77), started.Battle.ID)
+	if assert.Len(t, started.Battle.Teams, 2) {
+		assert.Equal(t, "1", started.Battle.Teams[0].ID)
+		assert.Equal(t, 3, started.Battle.Teams[0].WinStreak)
+		if assert.Len(t, started.Battle.Teams[1].TopViewers, 1) {
+			assert.Equal(t, 50, started.Battle.Teams[1].TopViewers[0].Points)
+		}
+	}
+
+	score := trackBattleMsg(t, live, armiesMessage(2, pb.LinkMicBattleStatus_ARMY_ONGOING, 10, 20))
+	assert.Equal(t, BATTLE_SCORE, score.Phase)
+	assert.Equal(t, 20, score.Battle.Winner().Points)
+	assert.Equal(t, "2", score.Battle.Winner().Hosts[0].User.Nickname, "host kept from the battle message")
+
+	task := &pb.WebcastLinkmicBattleTaskMessage{
+		Header: &pb.Common{Method: "WebcastLinkmicBattleTaskMessage", MsgId: 3, CreateTime: 1000},
+		Data2:  1,
+		Data5:  &pb.WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2{Data1: 5, Data2: 10},
+	}
+	tasked := trackBattleMsg(t, live, task)
+	assert.Equal(t, BATTLE_TASK, tasked.Phase)
+	assert.Equal(t, int64(77), tasked.Battle.ID)
+	assert.Equal(t, [2]int{5, 10}, tasked.Battle.Task.Data5)
+	assert.Len(t, live.Battles(), 1, "a task does not finish the battle")
+
+	ended := trackBattleMsg(t, live, armiesMessage(4, pb.LinkMicBattleStatus_ARMY_FINISHED, 30, 20))
+	assert.Equal(t, BATTLE_ENDED, ended.Phase)
+	assert.Equal(t, "1", ended.Battle.Winner().ID)
+	assert.Len(t, live.Battles(), 1)
+
+	other := &pb.WebcastLinkMicBattlePunishFinish{Header: &pb.Common{Method: "WebcastLinkMicBattlePunishFinish", MsgId: 5, CreateTime: 1000}, Id1: 99}
+	unknown := trackBattleMsg(t, live, other)
+	assert.Equal(t, int64(99), unknown.Battle.ID)
+	assert.Len(t, live.Battles(), 1, "a battle that is not tracked does not finish the current one")
+
+	finish := &pb.WebcastLinkMicBattlePunishFinish{Header: &pb.Common{Method: "WebcastLinkMicBattlePunishFinish", MsgId: 6, CreateTime: 1000}}
+	finished := trackBattleMsg(t, live, finish)
+	assert.Equal(t, BATTLE_PUNISH_FINISHED, finished.Phase)
+	assert.Equal(t, 30, finished.Battle.Teams[0].Points)
+	assert.Empty(t, live.Battles())
+}
+
+func TestBattle2v2(t *testing.T) {
+	live := &Live{}
+	msg := micBattleMessage(1, pb.LinkMicBattleStatus_BATTLE_ONGOING)
+	msg.HostTeam = nil
+	msg.ViewerTeam = nil
+	msg.TeamData = nil
+	msg.HostData2V2 = []*pb.WebcastLinkMicBattle_Host2V2Data{
+		{TeamNumber: 1, TotalPoints: 15, Hostdata: []*pb.WebcastLinkMicBattle_Host2V2Data_HostData{{HostId: 1, Points: 10}, {HostId: 2, Points: 5}}},
+		{TeamNumber: 2, TotalPoints: 7, Hostdata: []*pb.WebcastLinkMicBattle_Host2V2Data_HostData{{HostId: 3, Points: 7}, {HostId: 4}}},
+	}
+	e := trackBattleMsg(t, live, msg)
+	if assert.Len(t, e.Battle.Teams, 2) {
+		assert.Len(t, e.Battle.Teams[0].Hosts, 2)
+		assert.Equal(t, "1", e.Battle.Winner().ID)
+	}
+
+	e = trackBattleMsg(t, live, armiesMessage(2, pb.LinkMicBattleStatus_ARMY_ONGOING, 12, 8))
+	assert.Equal(t, 20, e.Battle.Teams[0].Points, "team points are the sum of its hosts")
+}
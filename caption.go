@@ -0,0 +1,165 @@
+package gotiktoklive
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// captionMaxDuration is how long a caption stays on screen when the next caption comes later or there is none.
+const captionMaxDuration = 5 * time.Second
+
+// CaptionEvent is a line of the automatic captions TikTok generates for a live.
+type CaptionEvent struct {
+	MessageID int64
+	Timestamp int64
+	// Time is when the caption was spoken, on the server clock.
+	Time      time.Time
+	Segments  []CaptionSegment
+	isHistory bool
+}
+
+func (c CaptionEvent) IsHistory() bool {
+	return c.isHistory
+}
+
+func (c CaptionEvent) CreatedTimestamp() int64 {
+	return c.Timestamp
+}
+
+// CaptionSegment is the text of a caption in one language.
+type CaptionSegment struct {
+	Language string
+	Text     string
+}
+
+// Text returns the text of all segments, separated by new lines.
+func (c CaptionEvent) Text() string {
+	text := ""
+	for i, s := range c.Segments {
+		if i > 0 {
+			text += "\n"
+		}
+		text += s.Text
+	}
+	return text
+}
+
+func toCaptionEvent(pt *pb.WebcastCaptionMessage, isHistory bool) CaptionEvent {
+	e := CaptionEvent{
+		MessageID: pt.Common.MsgId,
+		Timestamp: pt.Common.CreateTime,
+		Time:      unixTime(pt.TimeStamp, pt.Common.CreateTime),
+		isHistory: isHistory,
+	}
+	if d := pt.CaptionData; d != nil && d.Text != "" {
+		e.Segments = append(e.Segments, CaptionSegment{Language: d.Language, Text: d.Text})
+	}
+	return e
+}
+
+// CaptionFormat is a subtitle file format written by CaptionWriter.
+type CaptionFormat string
+
+const (
+	CAPTION_SRT    CaptionFormat = "srt"
+	CAPTION_WEBVTT CaptionFormat = "vtt"
+)
+
+// CaptionWriter writes captions as SRT or WebVTT subtitles, timed relative to the start of the stream so they line up
+// with a recording of it. A cue is shown until the next caption, at most 5 seconds, so every cue is written once the
+// next one is known and the last one on Close.
+type CaptionWriter struct {
+	w       *bufio.Writer
+	format  CaptionFormat
+	start   time.Time
+	pending *CaptionEvent
+	cues    int
+	err     error
+}
+
+// NewCaptionWriter creates a writer of captions in format to w. Cue times are relative to start, usually the room
+// start time time.Unix(live.Info.CreateTime, 0), when start is zero the first caption starts at 0.
+func NewCaptionWriter(w io.Writer, format CaptionFormat, start time.Time) (*CaptionWriter, error) {
+	if format != CAPTION_SRT && format != CAPTION_WEBVTT {
+		return nil, fmt.Errorf("unknown caption format %q", format)
+	}
+	cw := &CaptionWriter{w: bufio.NewWriter(w), format: format, start: start}
+	if format == CAPTION_WEBVTT {
+		_, cw.err = cw.w.WriteString("WEBVTT\n\n")
+	}
+	return cw, cw.err
+}
+
+// NewCaptionWriter creates a caption writer timed relative to the start of the live, see NewCaptionWriter.
+func (l *Live) NewCaptionWriter(w io.Writer, format CaptionFormat) (*CaptionWriter, error) {
+	var start time.Time
+	if l.Info != nil && l.Info.CreateTime > 0 {
+		start = time.Unix(l.Info.CreateTime, 0)
+	}
+	return NewCaptionWriter(w, format, start)
+}
+
+// Write adds a caption. Captions must be written in order, those without text are skipped. To write a single language
+// filter the segments before writing.
+func (cw *CaptionWriter) Write(e CaptionEvent) error {
+	if cw.err != nil {
+		return cw.err
+	}
+	if e.Text() == "" {
+		return nil
+	}
+	if cw.start.IsZero() {
+		cw.start = e.Time
+	}
+	if cw.pending != nil {
+		cw.err = cw.writeCue(*cw.pending, e.Time)
+	}
+	cw.pending = &e
+	return cw.err
+}
+
+// Close writes the last caption and flushes the output, it does not close the underlying writer.
+func (cw *CaptionWriter) Close() error {
+	if cw.err != nil {
+		return cw.err
+	}
+	if cw.pending != nil {
+		cw.err = cw.writeCue(*cw.pending, time.Time{})
+		cw.pending = nil
+	}
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.err
+}
+
+func (cw *CaptionWriter) writeCue(e CaptionEvent, next time.Time) error {
+	from := e.Time.Sub(cw.start)
+	if from < 0 {
+		from = 0
+	}
+	to := from + captionMaxDuration
+	if !next.IsZero() {
+		if d := next.Sub(cw.start); d > from && d < to {
+			to = d
+		}
+	}
+	cw.cues++
+	var err error
+	if cw.format == CAPTION_SRT {
+		_, err = fmt.Fprintf(cw.w, "%d\n%s --> %s\n%s\n\n", cw.cues, captionTimestamp(from, ','), captionTimestamp(to, ','), e.Text())
+	} else {
+		_, err = fmt.Fprintf(cw.w, "%s --> %s\n%s\n\n", captionTimestamp(from, '.'), captionTimestamp(to, '.'), e.Text())
+	}
+	return err
+}
+
+// captionTimestamp formats d as hh:mm:ss followed by sep and the milliseconds.
+func captionTimestamp(d time.Duration, sep byte) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
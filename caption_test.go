@@ -0,0 +1,60 @@
+package gotiktoklive
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func TestCaptionEvent(t *testing.T) {
+	msg := &pb.WebcastCaptionMessage{
+		Common:      &pb.Common{Method: "WebcastCaptionMessage", MsgId: 1, CreateTime: 1700000002000},
+		TimeStamp:   1700000001,
+		CaptionData: &pb.WebcastCaptionMessage_CaptionData{Language: "en", Text: "hello"},
+	}
+	e, ok := parseTestMsg(t, msg).(CaptionEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, time.Unix(1700000001, 0), e.Time)
+	assert.Equal(t, []CaptionSegment{{Language: "en", Text: "hello"}}, e.Segments)
+
+	msg.TimeStamp = 0
+	e = parseTestMsg(t, msg).(CaptionEvent)
+	assert.Equal(t, time.UnixMilli(1700000002000), e.Time)
+}
+
+func TestCaptionWriter(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	caption := func(at time.Duration, text string) CaptionEvent {
+		return CaptionEvent{Time: start.Add(at), Segments: []CaptionSegment{{Language: "en", Text: text}}}
+	}
+	write := func(format CaptionFormat) string {
+		var buf bytes.Buffer
+		w, err := NewCaptionWriter(&buf, format, start)
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		assert.NoError(t, w.Write(caption(time.Second, "hello")))
+		assert.NoError(t, w.Write(CaptionEvent{Time: start.Add(2 * time.Second)}))
+		assert.NoError(t, w.Write(caption(3*time.Second+250*time.Millisecond, "world")))
+		assert.NoError(t, w.Write(caption(time.Hour+20*time.Second, "later")))
+		assert.NoError(t, w.Close())
+		return buf.String()
+	}
+
+	assert.Equal(t, "1\n00:00:01,000 --> 00:00:03,250\nhello\n\n"+
+		"2\n00:00:03,250 --> 00:00:08,250\nworld\n\n"+
+		"3\n01:00:20,000 --> 01:00:25,000\nlater\n\n", write(CAPTION_SRT))
+	assert.Equal(t, "WEBVTT\n\n"+
+		"00:00:01.000 --> 00:00:03.250\nhello\n\n"+
+		"00:00:03.250 --> 00:00:08.250\nworld\n\n"+
+		"01:00:20.000 --> 01:00:25.000\nlater\n\n", write(CAPTION_WEBVTT))
+
+	_, err := NewCaptionWriter(&bytes.Buffer{}, "ass", start)
+	assert.Error(t, err)
+}
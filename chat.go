@@ -0,0 +1,128 @@
+package gotiktoklive
+
+import (
+	"sync"
+
+	"github.com/erni27/imcache"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// retractedCapacity is how many deleted message ids a Live remembers for Live.Retracted.
+const retractedCapacity = 10000
+
+// ChatDeleteEvent is sent when a moderator deletes comments, either by message id or all recent messages of a user.
+type ChatDeleteEvent struct {
+	MessageID  int64
+	Timestamp  int64
+	MessageIDs []int64
+	UserIDs    []int64
+	// Removed are the comments taken out of the recent chat buffer, it is only set with EnableChatBuffer.
+	Removed   []ChatEvent
+	isHistory bool
+}
+
+func (c ChatDeleteEvent) IsHistory() bool {
+	return c.isHistory
+}
+
+func (c ChatDeleteEvent) CreatedTimestamp() int64 {
+	return c.Timestamp
+}
+
+func toChatDeleteEvent(pt *pb.WebcastImDeleteMessage, isHistory bool) ChatDeleteEvent {
+	return ChatDeleteEvent{
+		MessageID:  pt.Common.MsgId,
+		Timestamp:  pt.Common.CreateTime,
+		MessageIDs: pt.DeleteMsgIdsList,
+		UserIDs:    pt.DeleteUserIdsList,
+		isHistory:  isHistory,
+	}
+}
+
+// chatTracker keeps the ids of deleted comments and, when enabled, a buffer of the most recent comments.
+type chatTracker struct {
+	mu        sync.Mutex
+	size      int
+	recent    []ChatEvent
+	retracted *imcache.Cache[int64, struct{}]
+}
+
+// trackChat adds the comment to the recent chat buffer. It returns the comment to send upstream, none when it was
+// already deleted, as comments of the room data can arrive after their deletion.
+func (l *Live) trackChat(e ChatEvent) []Event {
+	tr := &l.chat
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.retracted != nil {
+		if _, ok := tr.retracted.Get(e.MessageID); ok {
+			return nil
+		}
+	}
+	if tr.size == 0 {
+		return []Event{e}
+	}
+	if len(tr.recent) == tr.size {
+		copy(tr.recent, tr.recent[1:])
+		tr.recent = tr.recent[:tr.size-1]
+	}
+	tr.recent = append(tr.recent, e)
+	return []Event{e}
+}
+
+func (l *Live) trackChatDelete(e ChatDeleteEvent) ChatDeleteEvent {
+	tr := &l.chat
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.retracted == nil {
+		tr.retracted = imcache.New(
+			imcache.WithMaxEntriesLimitOption[int64, struct{}](retractedCapacity, imcache.EvictionPolicyLRU),
+		)
+	}
+	for _, id := range e.MessageIDs {
+		tr.retracted.Set(id, struct{}{}, imcache.WithNoExpiration())
+	}
+
+	deleted := make(map[int64]bool, len(e.MessageIDs))
+	for _, id := range e.MessageIDs {
+		deleted[id] = true
+	}
+	users := make(map[int64]bool, len(e.UserIDs))
+	for _, id := range e.UserIDs {
+		users[id] = true
+	}
+	kept := tr.recent[:0]
+	for _, c := range tr.recent {
+		if deleted[c.MessageID] || (c.User != nil && users[c.User.ID]) {
+			tr.retracted.Set(c.MessageID, struct{}{}, imcache.WithNoExpiration())
+			e.Removed = append(e.Removed, c)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	clear(tr.recent[len(kept):])
+	tr.recent = kept
+	return e
+}
+
+// RecentChat returns the most recent comments of the live, oldest first, without the deleted ones. It is empty unless
+// EnableChatBuffer is used.
+func (l *Live) RecentChat() []ChatEvent {
+	tr := &l.chat
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return append([]ChatEvent(nil), tr.recent...)
+}
+
+// Retracted tells if the comment with the message id was deleted by a moderator. Comments deleted along with all
+// messages of a user are only known with EnableChatBuffer, and only while they are in the buffer when deleted.
+func (l *Live) Retracted(msgID int64) bool {
+	tr := &l.chat
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.retracted == nil {
+		return false
+	}
+	_, ok := tr.retracted.Get(msgID)
+	return ok
+}
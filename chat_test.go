@@ -0,0 +1,82 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func deleteMessage(msgID int64, msgIDs, userIDs []int64) *pb.WebcastImDeleteMessage {
+	return &pb.WebcastImDeleteMessage{
+		Common:            &pb.Common{Method: "WebcastImDeleteMessage", MsgId: msgID, CreateTime: time.Now().UnixMilli()},
+		DeleteMsgIdsList:  msgIDs,
+		DeleteUserIdsList: userIDs,
+	}
+}
+
+func TestChatDelete(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv, EnableChatBuffer(3))
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	other := chatMessage(6004, "other")
+	other.User = &pb.User{Id: 2, Nickname: "other"}
+	assert.NoError(t, srv.PushMessages(
+		webcasttest.NewMessage(chatMessage(6001, "one")),
+		webcasttest.NewMessage(chatMessage(6002, "two")),
+		webcasttest.NewMessage(chatMessage(6003, "three")),
+		webcasttest.NewMessage(other),
+	))
+	for i := 0; i < 4; i++ {
+		nextEvent[ChatEvent](t, live.Events)
+	}
+	recent := live.RecentChat()
+	if assert.Len(t, recent, 3, "buffer is bounded") {
+		assert.Equal(t, int64(6002), recent[0].MessageID)
+	}
+
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(deleteMessage(6005, []int64{6004}, nil))))
+	deleted := nextEvent[ChatDeleteEvent](t, live.Events)
+	assert.Equal(t, []int64{6004}, deleted.MessageIDs)
+	if assert.Len(t, deleted.Removed, 1) {
+		assert.Equal(t, "other", deleted.Removed[0].Comment)
+	}
+	assert.True(t, live.Retracted(6004))
+	assert.False(t, live.Retracted(6003))
+
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(deleteMessage(6006, nil, []int64{1}))))
+	deleted = nextEvent[ChatDeleteEvent](t, live.Events)
+	assert.Len(t, deleted.Removed, 2)
+	assert.True(t, live.Retracted(6003))
+	assert.Empty(t, live.RecentChat())
+}
+
+func TestChatDeleteWithoutBuffer(t *testing.T) {
+	e, ok := parseTestMsg(t, deleteMessage(1, []int64{10, 11}, []int64{20})).(ChatDeleteEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, []int64{10, 11}, e.MessageIDs)
+		assert.Equal(t, []int64{20}, e.UserIDs)
+	}
+
+	live := &Live{}
+	assert.Len(t, live.track(e), 1)
+	assert.True(t, live.Retracted(10))
+	assert.Empty(t, live.RecentChat())
+
+	// Comments that arrive after their deletion are not sent.
+	assert.Empty(t, live.track(ChatEvent{MessageID: 10, Comment: "deleted"}))
+	assert.Len(t, live.track(ChatEvent{MessageID: 12, Comment: "kept"}), 1)
+}
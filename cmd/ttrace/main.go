@@ -0,0 +1,143 @@
+// Command ttrace reads websocket traces written with gotiktoklive.EnableWSTrace. It prints the events of the received
+// frames as text or JSON lines, or converts the trace into a recording that can be played with gotiktoklive.ReplayLive.
+//
+// Usage:
+//
+//	ttrace [-format text|json|record] [-o output] [-room id] [-experimental] [trace file]
+//
+// The trace is read from stdin when no file is given.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/steampoweredtaco/gotiktoklive"
+)
+
+func main() {
+	format := flag.String("format", "text", "output format: text, json or record")
+	output := flag.String("o", "", "output file, defaults to stdout")
+	roomID := flag.String("room", "", "room id for the recording, taken from the trace when empty")
+	experimental := flag.Bool("experimental", false, "enable experimental events")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [trace file]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*format, *output, *roomID, *experimental, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "ttrace:", err)
+		os.Exit(1)
+	}
+}
+
+func run(format, output, roomID string, experimental bool, args []string) error {
+	var in io.Reader = os.Stdin
+	switch len(args) {
+	case 0:
+	case 1:
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	default:
+		return errors.New("only one trace file can be given")
+	}
+
+	if output == "" {
+		return write(in, os.Stdout, format, roomID, experimental)
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if err := write(in, f, format, roomID, experimental); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// write writes the trace read from in to out in format, closing out is left to the caller.
+func write(in io.Reader, out io.Writer, format, roomID string, experimental bool) error {
+	switch format {
+	case "record":
+		// Hide that out is an io.Closer, a RecordWriter would close it.
+		w, err := gotiktoklive.NewRecordWriter(struct{ io.Writer }{out})
+		if err != nil {
+			return err
+		}
+		frames, err := gotiktoklive.ConvertTrace(in, w, roomID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "converted %d frames\n", frames)
+		return w.Close()
+	case "text", "json":
+		bw := bufio.NewWriter(out)
+		if err := printEvents(in, bw, format == "json", experimental); err != nil {
+			return err
+		}
+		return bw.Flush()
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// jsonEvent is a JSON line written for every event.
+type jsonEvent struct {
+	Time  time.Time          `json:"time"`
+	Type  string             `json:"type"`
+	Event gotiktoklive.Event `json:"event"`
+}
+
+func printEvents(in io.Reader, out io.Writer, asJSON, experimental bool) error {
+	tr := gotiktoklive.NewTraceReader(in)
+	enc := json.NewEncoder(out)
+	for {
+		entry, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !entry.Inbound || entry.Note != "" {
+			continue
+		}
+		_, rsp, err := gotiktoklive.DecodeFrame(entry.Data)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", entry.Time.Format(time.RFC3339Nano), err)
+			continue
+		}
+		if rsp == nil {
+			continue
+		}
+		events, err := gotiktoklive.ParseResponse(rsp, experimental)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", entry.Time.Format(time.RFC3339Nano), err)
+		}
+		for _, e := range events {
+			name := reflect.Indirect(reflect.ValueOf(e)).Type().Name()
+			if asJSON {
+				if err := enc.Encode(jsonEvent{Time: entry.Time, Type: name, Event: e}); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(out, "%s %s %+v\n", entry.Time.Format(time.RFC3339Nano), name, e); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// CoHostState is the state of a co-host or guest linked, or about to be linked, into the live.
+type CoHostState string
+
+const (
+	COHOST_INVITED  CoHostState = "invited"
+	COHOST_APPLIED  CoHostState = "applied to join"
+	COHOST_LINKED   CoHostState = "linked"
+	COHOST_DECLINED CoHostState = "declined"
+	COHOST_KICKED   CoHostState = "kicked out"
+	COHOST_LEFT     CoHostState = "left"
+)
+
+// active tells if a co-host in the state is part of Live.CoHosts.
+func (s CoHostState) active() bool {
+	return s == COHOST_INVITED || s == COHOST_APPLIED || s == COHOST_LINKED
+}
+
+// CoHost is a host or guest linked into the live, from co-host sessions between hosts and from multi-guest sessions.
+type CoHost struct {
+	UserID int64
+	// User is nil when TikTok only sent the user id so far.
+	User      *User
+	LinkMicID string
+	State     CoHostState
+	Muted     bool
+	// Position is the slot of the co-host in the layout as sent by TikTok, 0 when unknown.
+	Position int
+	// Since is when the co-host entered State.
+	Since time.Time
+}
+
+// CoHostChange is a co-host after a change, Previous is its state before, empty when the co-host was not known.
+type CoHostChange struct {
+	CoHost
+	Previous CoHostState
+}
+
+// CoHostChangeEvent is sent when co-hosts or guests are invited, apply, join, are muted, kicked out or leave the live,
+// or when they move in the layout. Live.CoHosts returns the current co-hosts.
+type CoHostChangeEvent struct {
+	MessageID int64
+	Timestamp int64
+	Changes   []CoHostChange
+	// deltas are the changes as described by the message, turned into Changes by the tracker.
+	deltas []coHostDelta
+	// complete are the states the message lists in full, co-hosts in them that are not listed have left.
+	complete  []CoHostState
+	isHistory bool
+}
+
+func (c CoHostChangeEvent) IsHistory() bool {
+	return c.isHistory
+}
+
+func (c CoHostChangeEvent) CreatedTimestamp() int64 {
+	return c.Timestamp
+}
+
+// coHostDelta is what a message tells about a co-host, zero values are left unchanged.
+type coHostDelta struct {
+	userID    int64
+	user      *User
+	linkMicID string
+	state     CoHostState
+	muted     *bool
+	position  int
+}
+
+func toCoHostChangeEvent(common *pb.Common, deltas []coHostDelta, complete []CoHostState, isHistory bool) CoHostChangeEvent {
+	e := CoHostChangeEvent{
+		MessageID: common.MsgId,
+		Timestamp: common.CreateTime,
+		deltas:    deltas,
+		complete:  complete,
+		isHistory: isHistory,
+	}
+	// Without the tracker, e.g. with ParseResponse, the changes are what the message describes.
+	for _, d := range deltas {
+		e.Changes = append(e.Changes, CoHostChange{CoHost: d.apply(CoHost{UserID: d.userID}, time.UnixMilli(common.CreateTime))})
+	}
+	return e
+}
+
+func (d coHostDelta) apply(c CoHost, now time.Time) CoHost {
+	if d.user != nil {
+		c.User = d.user
+	}
+	if d.linkMicID != "" {
+		c.LinkMicID = d.linkMicID
+	}
+	if d.state != "" && d.state != c.State {
+		c.State = d.state
+		c.Since = now
+	}
+	if d.muted != nil {
+		c.Muted = *d.muted
+	}
+	if d.position != 0 {
+		c.Position = d.position
+	}
+	return c
+}
+
+func replyState(status pb.ReplyStatus) CoHostState {
+	if status == pb.ReplyStatus_REPLY_STATUS_AGREE {
+		return COHOST_LINKED
+	}
+	return COHOST_DECLINED
+}
+
+func listUserDelta(u *pb.ListUser, state CoHostState) coHostDelta {
+	muted := u.SilenceStatus != 0
+	d := coHostDelta{
+		linkMicID: u.LinkmicIdStr,
+		state:     state,
+		muted:     &muted,
+		position:  int(u.UserPosition),
+	}
+	if u.User != nil {
+		d.user = toUser(u.User)
+		d.userID = d.user.ID
+	}
+	if d.linkMicID == "" && u.LinkmicId != 0 {
+		d.linkMicID = strconv.FormatInt(u.LinkmicId, 10)
+	}
+	return d
+}
+
+func layerUserDelta(u *pb.LinkLayerListUser, state CoHostState) coHostDelta {
+	d := coHostDelta{state: state, position: int(u.GetPos().GetLink().GetPosition())}
+	if u.User != nil {
+		d.user = toUser(u.User)
+		d.userID = d.user.ID
+	}
+	if u.LinkmicId != 0 {
+		d.linkMicID = strconv.FormatInt(u.LinkmicId, 10)
+	}
+	return d
+}
+
+func playerDelta(p *pb.Player, state CoHostState) coHostDelta {
+	return coHostDelta{userID: p.GetUserId(), state: state}
+}
+
+// toLinkEvent converts the link messages of co-host sessions between hosts.
+func toLinkEvent(pt *pb.WebcastLinkMessage, isHistory bool) CoHostChangeEvent {
+	var deltas []coHostDelta
+	var complete []CoHostState
+	switch {
+	case pt.InviteContent != nil:
+		c := pt.InviteContent
+		d := coHostDelta{userID: c.FromUserId, state: COHOST_INVITED, position: int(c.RequiredMicIdx)}
+		if c.FromUser != nil {
+			d.user = toUser(c.FromUser)
+		}
+		deltas = append(deltas, d)
+	case pt.ReplyContent != nil:
+		c := pt.ReplyContent
+		state := COHOST_DECLINED
+		if c.ReplyStatus == int64(pb.ReplyStatus_REPLY_STATUS_AGREE) {
+			state = COHOST_LINKED
+		}
+		from := coHostDelta{userID: c.FromUserId, state: state}
+		if c.FromUser != nil {
+			from.user = toUser(c.FromUser)
+		}
+		to := coHostDelta{userID: c.ToUserId, state: state}
+		if c.ToUser != nil {
+			to.user = toUser(c.ToUser)
+		}
+		deltas = append(deltas, from, to)
+	case pt.EnterContent != nil:
+		for _, u := range pt.EnterContent.LinkedUsersList {
+			deltas = append(deltas, listUserDelta(u, COHOST_LINKED))
+		}
+		complete = []CoHostState{COHOST_LINKED}
+	case pt.LeaveContent != nil:
+		deltas = append(deltas, coHostDelta{userID: pt.LeaveContent.UserId, linkMicID: pt.LeaveContent.LinkmicIdStr, state: COHOST_LEFT})
+	case pt.CancelContent != nil:
+		deltas = append(deltas, coHostDelta{userID: pt.CancelContent.ToUserId, state: COHOST_LEFT})
+	case pt.KickOutContent != nil:
+		deltas = append(deltas, coHostDelta{userID: pt.KickOutContent.FromUserId, state: COHOST_KICKED})
+	case pt.SysKickOutContent != nil:
+		deltas = append(deltas, coHostDelta{userID: pt.SysKickOutContent.UserId, linkMicID: pt.SysKickOutContent.LinkmicIdStr, state: COHOST_KICKED})
+	case pt.MuteContent != nil:
+		muted := pt.MuteContent.Status != 0
+		deltas = append(deltas, coHostDelta{userID: pt.MuteContent.UserId, muted: &muted})
+	case pt.ListChangeContent != nil:
+		c := pt.ListChangeContent
+		for _, u := range c.LinkedUsers {
+			deltas = append(deltas, listUserDelta(u, COHOST_LINKED))
+		}
+		for _, u := range c.AppliedUsers {
+			deltas = append(deltas, listUserDelta(u, COHOST_APPLIED))
+		}
+		for _, u := range c.ConnectingUsers {
+			deltas = append(deltas, listUserDelta(u, COHOST_INVITED))
+		}
+		complete = []CoHostState{COHOST_LINKED, COHOST_APPLIED, COHOST_INVITED}
+	case pt.CloseContent != nil:
+		complete = []CoHostState{COHOST_LINKED, COHOST_APPLIED, COHOST_INVITED}
+	}
+	return toCoHostChangeEvent(pt.Common, deltas, complete, isHistory)
+}
+
+// toLinkLayerEvent converts the link layer messages of multi-guest sessions.
+func toLinkLayerEvent(pt *pb.WebcastLinkLayerMessage, isHistory bool) CoHostChangeEvent {
+	var deltas []coHostDelta
+	var complete []CoHostState
+	allUsers := func(all *pb.AllListUser) {
+		for _, u := range all.GetLinkedList() {
+			deltas = append(deltas, layerUserDelta(u, COHOST_LINKED))
+		}
+		for _, u := range all.GetAppliedList() {
+			deltas = append(deltas, layerUserDelta(u, COHOST_APPLIED))
+		}
+		for _, u := range all.GetInvitedList() {
+			deltas = append(deltas, layerUserDelta(u, COHOST_INVITED))
+		}
+		complete = []CoHostState{COHOST_LINKED, COHOST_APPLIED, COHOST_INVITED}
+	}
+	switch {
+	case pt.InviteContent != nil:
+		c := pt.InviteContent
+		d := coHostDelta{state: COHOST_INVITED, linkMicID: c.InviteeLinkMicId, position: int(c.GetPos().GetLink().GetPosition())}
+		if c.Invitee != nil {
+			d.user = toUser(c.Invitee)
+			d.userID = d.user.ID
+		}
+		deltas = append(deltas, d)
+	case pt.ApplyContent != nil:
+		d := playerDelta(pt.ApplyContent.Applier, COHOST_APPLIED)
+		d.linkMicID = pt.ApplyContent.ApplierLinkMicId
+		deltas = append(deltas, d)
+	case pt.PermitApplyContent != nil:
+		c := pt.PermitApplyContent
+		d := coHostDelta{state: replyState(c.ReplyStatus), linkMicID: c.ApplierLinkMicId, position: int(c.GetApplierPos().GetLink().GetPosition())}
+		if c.Applier != nil {
+			d.user = toUser(c.Applier)
+			d.userID = d.user.ID
+		}
+		deltas = append(deltas, d)
+	case pt.ReplyInviteContent != nil:
+		c := pt.ReplyInviteContent
+		d := playerDelta(c.Invitee, replyState(c.ReplyStatus))
+		d.linkMicID = c.InviteeLinkMicId
+		d.position = int(c.GetInviteePos().GetLink().GetPosition())
+		deltas = append(deltas, d)
+	case pt.KickOutContent != nil:
+		deltas = append(deltas, playerDelta(pt.KickOutContent.Offliner, COHOST_KICKED))
+	case pt.CancelApplyContent != nil:
+		deltas = append(deltas, playerDelta(pt.CancelApplyContent.Applier, COHOST_LEFT))
+	case pt.CancelInviteContent != nil:
+		deltas = append(deltas, playerDelta(pt.CancelInviteContent.Invitee, COHOST_LEFT))
+	case pt.LeaveContent != nil:
+		deltas = append(deltas, playerDelta(pt.LeaveContent.Leaver, COHOST_LEFT))
+	case pt.FinishContent != nil:
+		complete = []CoHostState{COHOST_LINKED, COHOST_APPLIED, COHOST_INVITED}
+	case pt.JoinDirectContent != nil:
+		if pt.JoinDirectContent.AllUsers != nil {
+			allUsers(pt.JoinDirectContent.AllUsers)
+		} else if pt.JoinDirectContent.Joiner != nil {
+			deltas = append(deltas, layerUserDelta(pt.JoinDirectContent.Joiner, COHOST_LINKED))
+		}
+	case pt.ListChangeContent != nil:
+		allUsers(pt.ListChangeContent.List)
+	}
+	deltas = multiLiveDeltas(deltas, pt.GetBusinessContent().GetMultiLiveContent())
+	return toCoHostChangeEvent(pt.Common, deltas, complete, isHistory)
+}
+
+// multiLiveDeltas adds the users of the multi-guest business content sent along a link layer message to its deltas,
+// the contents of the message itself often only have the user id. The permit content only has the host who permitted
+// and is not used.
+func multiLiveDeltas(deltas []coHostDelta, c *pb.MultiLiveContent) []coHostDelta {
+	add := func(u *pb.User, state CoHostState) {
+		if u == nil {
+			return
+		}
+		user := toUser(u)
+		for i := range deltas {
+			if deltas[i].userID == user.ID {
+				if deltas[i].user == nil {
+					deltas[i].user = user
+				}
+				if deltas[i].state == "" {
+					deltas[i].state = state
+				}
+				return
+			}
+		}
+		deltas = append(deltas, coHostDelta{userID: user.ID, user: user, state: state})
+	}
+	if c == nil {
+		return deltas
+	}
+	if biz := c.InviteBizContent; biz != nil {
+		add(biz.InviteeUserInfo, COHOST_INVITED)
+	}
+	if biz := c.ReplyBizContent; biz != nil {
+		// The reply does not tell if the invite was accepted, that comes with the reply invite content.
+		add(biz.ReplyUserInfo, "")
+	}
+	if biz := c.KickOutBizContent; biz != nil {
+		add(biz.KickPlayerUserInfo, COHOST_KICKED)
+	}
+	return deltas
+}
+
+// coHostTracker keeps the co-hosts of a live by user id.
+type coHostTracker struct {
+	mu      sync.Mutex
+	coHosts map[int64]CoHost
+}
+
+// trackCoHosts applies the changes of a link message to the known co-hosts. It returns the event with the actual
+// changes, or false when nothing changed.
+func (l *Live) trackCoHosts(e CoHostChangeEvent) (CoHostChangeEvent, bool) {
+	tr := &l.coHosts
+	now := time.Now()
+	var owner int64
+	if l.Info != nil && l.Info.Owner != nil {
+		owner = l.Info.Owner.ID
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.coHosts == nil {
+		tr.coHosts = make(map[int64]CoHost)
+	}
+
+	var changes []CoHostChange
+	listed := make(map[int64]bool, len(e.deltas))
+	for _, d := range e.deltas {
+		if d.userID == 0 || d.userID == owner {
+			continue
+		}
+		listed[d.userID] = true
+		known, ok := tr.coHosts[d.userID]
+		if !ok {
+			known = CoHost{UserID: d.userID}
+		}
+		updated := d.apply(known, now)
+		if ok && sameCoHost(updated, known) {
+			tr.coHosts[d.userID] = updated
+			continue
+		}
+		if !ok && !updated.State.active() {
+			// Nothing to track for co-hosts that leave, are declined or muted before they were seen.
+			if updated.State != "" {
+				changes = append(changes, CoHostChange{CoHost: updated})
+			}
+			continue
+		}
+		changes = append(changes, CoHostChange{CoHost: updated, Previous: known.State})
+		tr.coHosts[d.userID] = updated
+	}
+	for id, known := range tr.coHosts {
+		if listed[id] || !containsState(e.complete, known.State) {
+			continue
+		}
+		left := known
+		left.State = COHOST_LEFT
+		left.Since = now
+		changes = append(changes, CoHostChange{CoHost: left, Previous: known.State})
+		tr.coHosts[id] = left
+	}
+	for id, known := range tr.coHosts {
+		if !known.State.active() {
+			delete(tr.coHosts, id)
+		}
+	}
+
+	e.Changes = changes
+	return e, len(changes) > 0
+}
+
+// sameCoHost tells if nothing visible changed between a and b.
+func sameCoHost(a, b CoHost) bool {
+	return a.State == b.State && a.Muted == b.Muted && a.Position == b.Position && a.LinkMicID == b.LinkMicID
+}
+
+func containsState(states []CoHostState, state CoHostState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// CoHosts returns the co-hosts and guests currently linked into the live, invited or applying to join, ordered by their
+// position in the layout.
+func (l *Live) CoHosts() []CoHost {
+	tr := &l.coHosts
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	coHosts := make([]CoHost, 0, len(tr.coHosts))
+	for _, c := range tr.coHosts {
+		coHosts = append(coHosts, c)
+	}
+	sort.Slice(coHosts, func(i, j int) bool {
+		if coHosts[i].Position != coHosts[j].Position {
+			return coHosts[i].Position < coHosts[j].Position
+		}
+		return coHosts[i].UserID < coHosts[j].UserID
+	})
+	return coHosts
+}
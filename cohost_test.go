@@ -0,0 +1,96 @@
+package gotiktoklive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func linkLayerMessage(msgID int64) *pb.WebcastLinkLayerMessage {
+	return &pb.WebcastLinkLayerMessage{Common: &pb.Common{Method: "WebcastLinkLayerMessage", MsgId: msgID, CreateTime: 1000}}
+}
+
+func layerUser(id int64, position int32) *pb.LinkLayerListUser {
+	return &pb.LinkLayerListUser{
+		User: &pb.User{Id: id, Nickname: "guest"},
+		Pos:  &pb.Position{Link: &pb.LinkPosition{Position: position}},
+	}
+}
+
+// trackCoHostMsg parses and tracks m, returning the changes or nil when the event was not sent.
+func trackCoHostMsg(t *testing.T, live *Live, m proto.Message) []CoHostChange {
+	t.Helper()
+	tracked := live.track(parseTestMsg(t, m))
+	if len(tracked) == 0 {
+		return nil
+	}
+	return tracked[0].(CoHostChangeEvent).Changes
+}
+
+func TestCoHosts(t *testing.T) {
+	live := &Live{}
+
+	invite := linkLayerMessage(1)
+	invite.InviteContent = &pb.InviteContent{Invitee: &pb.User{Id: 10, Nickname: "guest"}, Pos: &pb.Position{Link: &pb.LinkPosition{Position: 2}}}
+	changes := trackCoHostMsg(t, live, invite)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, COHOST_INVITED, changes[0].State)
+		assert.Empty(t, changes[0].Previous)
+		assert.Equal(t, 2, changes[0].Position)
+	}
+
+	reply := linkLayerMessage(2)
+	reply.ReplyInviteContent = &pb.ReplyInviteContent{Invitee: &pb.Player{UserId: 10}, ReplyStatus: pb.ReplyStatus_REPLY_STATUS_AGREE}
+	changes = trackCoHostMsg(t, live, reply)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, COHOST_LINKED, changes[0].State)
+		assert.Equal(t, COHOST_INVITED, changes[0].Previous)
+		assert.Equal(t, "guest", changes[0].User.Nickname, "user kept from the invite")
+	}
+
+	list := linkLayerMessage(3)
+	list.ListChangeContent = &pb.ListChangeContent{List: &pb.AllListUser{LinkedList: []*pb.LinkLayerListUser{layerUser(10, 2), layerUser(11, 3)}}}
+	changes = trackCoHostMsg(t, live, list)
+	if assert.Len(t, changes, 1, "known co-host unchanged") {
+		assert.Equal(t, int64(11), changes[0].UserID)
+	}
+	assert.Nil(t, trackCoHostMsg(t, live, list), "no event without changes")
+
+	mute := &pb.WebcastLinkMessage{
+		Common:      &pb.Common{Method: "WebcastLinkMessage", MsgId: 4, CreateTime: 1000},
+		MuteContent: &pb.LinkerMuteContent{UserId: 11, Status: 1},
+	}
+	changes = trackCoHostMsg(t, live, mute)
+	if assert.Len(t, changes, 1) {
+		assert.True(t, changes[0].Muted)
+		assert.Equal(t, COHOST_LINKED, changes[0].State)
+	}
+
+	kick := linkLayerMessage(5)
+	kick.KickOutContent = &pb.KickOutContent{Offliner: &pb.Player{UserId: 10}}
+	kick.BusinessContent = &pb.BusinessContent{MultiLiveContent: &pb.MultiLiveContent{
+		KickOutBizContent: &pb.MultiLiveContent_KickOutBizContent{KickPlayerUserInfo: &pb.User{Id: 10, Nickname: "kicked guest"}},
+	}}
+	changes = trackCoHostMsg(t, live, kick)
+	if assert.Len(t, changes, 1, "business content merged with the kick out") {
+		assert.Equal(t, COHOST_KICKED, changes[0].State)
+		assert.Equal(t, "kicked guest", changes[0].User.Nickname)
+	}
+	coHosts := live.CoHosts()
+	if assert.Len(t, coHosts, 1) {
+		assert.Equal(t, int64(11), coHosts[0].UserID)
+		assert.Equal(t, 3, coHosts[0].Position)
+	}
+
+	finish := linkLayerMessage(6)
+	finish.FinishContent = &pb.FinishChannelContent{}
+	changes = trackCoHostMsg(t, live, finish)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, COHOST_LEFT, changes[0].State)
+		assert.Equal(t, COHOST_LINKED, changes[0].Previous)
+	}
+	assert.Empty(t, live.CoHosts())
+}
@@ -2,6 +2,7 @@ package gotiktoklive
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 )
 
@@ -106,6 +107,8 @@ var (
 	ErrFFMPEGNotFound    = errors.New("please install ffmpeg before downloading")
 	ErrRateLimitExceeded = errors.New("you have exceeded the rate limit, please wait a few min")
 	ErrUserInfoNotFound  = errors.New("user info not found")
+	ErrClosed            = errors.New("tiktok instance is closed")
+	ErrSignerLimits      = errors.New("cannot get signing limits")
 )
 
 type ErrIPBlockedOrBanned struct{}
@@ -113,3 +116,12 @@ type ErrIPBlockedOrBanned struct{}
 func (e ErrIPBlockedOrBanned) Error() string {
 	return "your IP or country might be blocked by TikTok or Signer service or you might be banned, please try again with a vpn, proxy, or different credentials"
 }
+
+// ErrBadStatusCode is returned when a request is answered with an unexpected status code.
+type ErrBadStatusCode struct {
+	StatusCode int
+}
+
+func (e ErrBadStatusCode) Error() string {
+	return fmt.Sprintf("received status code %d", e.StatusCode)
+}
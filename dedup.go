@@ -0,0 +1,61 @@
+package gotiktoklive
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/erni27/imcache"
+)
+
+const (
+	defaultDedupTTL      = 15 * time.Minute
+	defaultDedupCapacity = 10000
+)
+
+// DropCounters counts the events a Live did not send upstream.
+type DropCounters struct {
+	// Duplicates is the number of messages dropped because the Live already saw their message id, e.g. when the room
+	// data fetched after a reconnect repeats messages received before the connection was lost.
+	Duplicates uint64
+	// Overflow is the number of events discarded because the Events channel was full.
+	Overflow uint64
+}
+
+// dedup remembers the message ids a Live has seen for ttl, keeping at most capacity ids.
+type dedup struct {
+	seen       *imcache.Cache[int64, struct{}]
+	duplicates atomic.Uint64
+}
+
+func newDedup(ttl time.Duration, capacity int) *dedup {
+	d := &dedup{}
+	if ttl > 0 {
+		d.seen = imcache.New(
+			imcache.WithDefaultExpirationOption[int64, struct{}](ttl),
+			imcache.WithMaxEntriesLimitOption[int64, struct{}](capacity, imcache.EvictionPolicyLRU),
+		)
+	}
+	return d
+}
+
+// duplicate tells if the message id was already seen and counts it as dropped. Messages without an id are never
+// duplicates.
+func (d *dedup) duplicate(msgID int64) bool {
+	if d == nil || d.seen == nil || msgID == 0 {
+		return false
+	}
+	if _, present := d.seen.GetOrSet(msgID, struct{}{}, imcache.WithDefaultExpiration()); present {
+		d.duplicates.Add(1)
+		return true
+	}
+	return false
+}
+
+// Dropped returns how many events the Live dropped so far.
+func (l *Live) Dropped() DropCounters {
+	counters := DropCounters{Overflow: l.overflow.Load()}
+	if l.dedup != nil {
+		counters.Duplicates = l.dedup.duplicates.Load()
+	}
+	return counters
+}
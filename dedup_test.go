@@ -0,0 +1,68 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func TestDedupAfterReconnect(t *testing.T) {
+	srv := webcasttest.NewServer(webcasttest.WithRoomMessages(webcasttest.NewMessage(chatMessage(6001, "from room data"))))
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv, ReconnectBackoff(10*time.Millisecond, 50*time.Millisecond, 3))
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	chat := nextEvent[ChatEvent](t, live.Events)
+	assert.Equal(t, "from room data", chat.Comment)
+	assert.False(t, chat.IsHistory())
+
+	// A second live in the same process is not affected by the first one.
+	other, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer other.Close()
+	assert.Equal(t, "from room data", nextEvent[ChatEvent](t, other.Events).Comment)
+
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+	srv.DropConnections()
+	nextEvent[ReconnectedEvent](t, live.Events)
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	msg := webcasttest.NewMessage(chatMessage(6002, "after reconnect"))
+	if !assert.NoError(t, srv.PushMessages(msg)) {
+		return
+	}
+	assert.Equal(t, "after reconnect", nextEvent[ChatEvent](t, live.Events).Comment)
+	if !assert.NoError(t, srv.PushMessages(msg, webcasttest.NewMessage(chatMessage(6003, "new")))) {
+		return
+	}
+	assert.Equal(t, "new", nextEvent[ChatEvent](t, live.Events).Comment)
+	assert.Equal(t, uint64(2), live.Dropped().Duplicates)
+}
+
+func TestDedupDisabled(t *testing.T) {
+	d := newDedup(0, 0)
+	assert.False(t, d.duplicate(1))
+	assert.False(t, d.duplicate(1))
+
+	d = newDedup(time.Minute, 2)
+	assert.False(t, d.duplicate(0))
+	assert.False(t, d.duplicate(0), "messages without id are never duplicates")
+	assert.False(t, d.duplicate(1))
+	assert.True(t, d.duplicate(1))
+	assert.False(t, d.duplicate(2))
+	assert.False(t, d.duplicate(3))
+	assert.False(t, d.duplicate(1), "evicted when over capacity")
+}
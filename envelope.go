@@ -0,0 +1,188 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// envelopeExpireAfter is how long a treasure chest is kept after it opened when TikTok does not hide it sooner. TikTok
+// does not send how long a chest stays claimable, this is a local guess.
+const envelopeExpireAfter = time.Minute
+
+// EnvelopeState is the lifecycle state of a treasure chest.
+type EnvelopeState string
+
+const (
+	ENVELOPE_DROPPED EnvelopeState = "treasure chest dropped"
+	ENVELOPE_OPENED  EnvelopeState = "treasure chest can be opened"
+	ENVELOPE_EXPIRED EnvelopeState = "treasure chest expired"
+)
+
+// EnvelopeEvent is sent for every change of a treasure chest, see EnvelopeState. A chest is dropped with a countdown,
+// opens at UnpackAt and expires once TikTok hides it. TikTok does not send when a chest stops being claimable, so
+// when it does not hide the chest an ENVELOPE_EXPIRED is sent a minute after it opened, which is only a local guess.
+type EnvelopeEvent struct {
+	MessageID  int64
+	Timestamp  int64
+	EnvelopeID string
+	State      EnvelopeState
+	Sender     *User
+	Diamonds   int
+	People     int
+	// UnpackAt is when the chest opens, corrected for the difference between the server and the local clock so it
+	// can be used for local countdown timers.
+	UnpackAt time.Time
+	// FollowRequired is set when viewers need to follow the host to claim the chest.
+	FollowRequired bool
+	isHistory      bool
+}
+
+func (e EnvelopeEvent) IsHistory() bool {
+	return e.isHistory
+}
+
+func (e EnvelopeEvent) CreatedTimestamp() int64 {
+	return e.Timestamp
+}
+
+// Remaining is the countdown until the chest opens, zero once it opened.
+func (e EnvelopeEvent) Remaining() time.Duration {
+	if d := time.Until(e.UnpackAt); d > 0 {
+		return d
+	}
+	return 0
+}
+
+func toEnvelopeEvent(pt *pb.WebcastEnvelopeMessage, isHistory bool) EnvelopeEvent {
+	info := pt.GetEnvelopeInfo()
+	sender := &User{Username: info.GetSendUserName(), Nickname: info.GetSendUserName()}
+	sender.ID, _ = strconv.ParseInt(info.GetSendUserId(), 10, 64)
+	if avatar := info.GetSendUserAvatar(); avatar != nil && avatar.UrlList != nil {
+		sender.ProfilePicture = &ProfilePicture{Urls: avatar.UrlList}
+	}
+	state := ENVELOPE_DROPPED
+	if pt.Display == pb.EnvelopeDisplay_EnvelopeDisplayHide {
+		state = ENVELOPE_EXPIRED
+	}
+	return EnvelopeEvent{
+		MessageID:      pt.Common.MsgId,
+		Timestamp:      pt.Common.CreateTime,
+		EnvelopeID:     info.GetEnvelopeId(),
+		State:          state,
+		Sender:         sender,
+		Diamonds:       int(info.GetDiamondCount()),
+		People:         int(info.GetPeopleCount()),
+		UnpackAt:       unixTime(uint64(info.GetUnpackAt()), pt.Common.CreateTime),
+		FollowRequired: info.GetFollowShowStatus() == pb.EnvelopeFollowShowStatus_EnvelopeFollowShow,
+		isHistory:      isHistory,
+	}
+}
+
+type trackedEnvelope struct {
+	event  EnvelopeEvent
+	timers []*time.Timer
+}
+
+// envelopeTracker follows the treasure chests of a live by envelope id and sends the opened and expired events when
+// their time comes.
+type envelopeTracker struct {
+	mu        sync.Mutex
+	stopped   bool
+	envelopes map[string]*trackedEnvelope
+	// sending waits for the timer sends in flight.
+	sending sync.WaitGroup
+}
+
+// track updates the chest state from a received event. It returns the event to send upstream, or false when the
+// chest is already known in that state.
+func (l *Live) trackEnvelope(e EnvelopeEvent) (EnvelopeEvent, bool) {
+	tr := &l.envelopes
+	e.UnpackAt = l.localTime(e.UnpackAt)
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.envelopes == nil {
+		tr.envelopes = make(map[string]*trackedEnvelope)
+	}
+	known, ok := tr.envelopes[e.EnvelopeID]
+	if e.State == ENVELOPE_EXPIRED {
+		if !ok {
+			return e, true
+		}
+		for _, t := range known.timers {
+			t.Stop()
+		}
+		delete(tr.envelopes, e.EnvelopeID)
+		return e, true
+	}
+	if ok {
+		// TikTok repeats the chest while it is shown, keep the first drop.
+		return e, false
+	}
+	if e.EnvelopeID == "" || tr.stopped {
+		return e, true
+	}
+
+	tracked := &trackedEnvelope{event: e}
+	tr.envelopes[e.EnvelopeID] = tracked
+	opensIn := time.Until(e.UnpackAt)
+	tracked.timers = append(tracked.timers,
+		time.AfterFunc(opensIn, func() { l.envelopeTimer(e.EnvelopeID, ENVELOPE_OPENED) }),
+		time.AfterFunc(opensIn+envelopeExpireAfter, func() { l.envelopeTimer(e.EnvelopeID, ENVELOPE_EXPIRED) }),
+	)
+	return e, true
+}
+
+func (l *Live) envelopeTimer(id string, state EnvelopeState) {
+	tr := &l.envelopes
+	tr.mu.Lock()
+	tracked, ok := tr.envelopes[id]
+	if !ok || tr.stopped {
+		tr.mu.Unlock()
+		return
+	}
+	if state == ENVELOPE_EXPIRED {
+		delete(tr.envelopes, id)
+	}
+	tracked.event.State = state
+	tracked.event.Timestamp = l.serverNow().UnixMilli()
+	tracked.event.isHistory = false
+	e := tracked.event
+	// stop waits for the send so nothing is sent once the Events channel can be closed.
+	tr.sending.Add(1)
+	tr.mu.Unlock()
+	defer tr.sending.Done()
+	l.sendEvent(e)
+}
+
+// stop cancels all pending timers, after it returns no more events are sent.
+func (tr *envelopeTracker) stop() {
+	tr.mu.Lock()
+	tr.stopped = true
+	for _, tracked := range tr.envelopes {
+		for _, t := range tracked.timers {
+			t.Stop()
+		}
+	}
+	tr.mu.Unlock()
+	tr.sending.Wait()
+}
+
+// Envelopes returns the treasure chests that are currently dropped or open, ordered by when they open.
+func (l *Live) Envelopes() []EnvelopeEvent {
+	tr := &l.envelopes
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	envelopes := make([]EnvelopeEvent, 0, len(tr.envelopes))
+	for _, tracked := range tr.envelopes {
+		envelopes = append(envelopes, tracked.event)
+	}
+	sort.Slice(envelopes, func(i, j int) bool {
+		return envelopes[i].UnpackAt.Before(envelopes[j].UnpackAt)
+	})
+	return envelopes
+}
@@ -0,0 +1,90 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func envelopeMessage(msgID int64, id string, unpackAt time.Time, display pb.EnvelopeDisplay) *pb.WebcastEnvelopeMessage {
+	return &pb.WebcastEnvelopeMessage{
+		Common: &pb.Common{Method: "WebcastEnvelopeMessage", MsgId: msgID, CreateTime: time.Now().UnixMilli()},
+		EnvelopeInfo: &pb.WebcastEnvelopeMessage_EnvelopeInfo{
+			EnvelopeId:       id,
+			SendUserName:     "sender",
+			SendUserId:       "42",
+			DiamondCount:     100,
+			PeopleCount:      10,
+			UnpackAt:         int32(unpackAt.Unix()),
+			FollowShowStatus: pb.EnvelopeFollowShowStatus_EnvelopeFollowShow,
+		},
+		Display: display,
+	}
+}
+
+func TestEnvelopeLifecycle(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	// The server clock is an hour ahead, the chest opens now on the server clock.
+	serverNow := time.Now().Add(time.Hour)
+	err = srv.Push(&pb.WebcastResponse{
+		Now:      serverNow.UnixMilli(),
+		Messages: []*pb.WebcastResponse_Message{webcasttest.NewMessage(envelopeMessage(7001, "chest", serverNow.Add(time.Second), pb.EnvelopeDisplay_EnvelopeDisplayNew))},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	dropped := nextEvent[EnvelopeEvent](t, live.Events)
+	assert.Equal(t, ENVELOPE_DROPPED, dropped.State)
+	assert.Equal(t, "chest", dropped.EnvelopeID)
+	assert.Equal(t, int64(42), dropped.Sender.ID)
+	assert.Equal(t, 100, dropped.Diamonds)
+	assert.True(t, dropped.FollowRequired)
+	assert.WithinDuration(t, time.Now().Add(time.Second), dropped.UnpackAt, 2*time.Second, "unpack time on the local clock")
+	if assert.Len(t, live.Envelopes(), 1) {
+		assert.Equal(t, "chest", live.Envelopes()[0].EnvelopeID)
+	}
+
+	// Repeats of a known chest are not sent again.
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(envelopeMessage(7002, "chest", serverNow.Add(time.Second), pb.EnvelopeDisplay_EnvelopeDisplayNew))))
+	opened := nextEvent[EnvelopeEvent](t, live.Events)
+	assert.Equal(t, ENVELOPE_OPENED, opened.State)
+	assert.Zero(t, opened.Remaining())
+
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(envelopeMessage(7003, "chest", serverNow, pb.EnvelopeDisplay_EnvelopeDisplayHide))))
+	expired := nextEvent[EnvelopeEvent](t, live.Events)
+	assert.Equal(t, ENVELOPE_EXPIRED, expired.State)
+	assert.Empty(t, live.Envelopes())
+}
+
+func TestEnvelopeTimerFullChannel(t *testing.T) {
+	live := &Live{Events: make(chan Event, 1)}
+	live.Events <- RoomEvent{Message: "old"}
+	e := toEnvelopeEvent(envelopeMessage(1, "chest", time.Now(), pb.EnvelopeDisplay_EnvelopeDisplayNew), false)
+	_, ok := live.trackEnvelope(e)
+	assert.True(t, ok)
+
+	// The opened event replaces the oldest event instead of waiting for the reader.
+	assert.Eventually(t, func() bool {
+		return live.overflow.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	live.stopTrackers()
+	if opened, ok := (<-live.Events).(EnvelopeEvent); assert.True(t, ok) {
+		assert.Equal(t, ENVELOPE_OPENED, opened.State)
+	}
+}
@@ -0,0 +1,216 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+// parseTestMsg parses m the way a Live parses a received message.
+func parseTestMsg(t *testing.T, m proto.Message) Event {
+	t.Helper()
+	e, err := parseMsg(webcasttest.NewMessage(m), t.Log, t.Log, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestSubscribeEvent(t *testing.T) {
+	type test struct {
+		msg    *pb.WebcastSubNotifyMessage
+		action SubscribeAction
+	}
+	common := func() *pb.Common { return &pb.Common{Method: "WebcastSubNotifyMessage", MsgId: 1, CreateTime: 1000} }
+	tests := map[string]test{
+		"first": {
+			msg:    &pb.WebcastSubNotifyMessage{SubMonth: 1, OldSubscribeStatus: pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_FIRST},
+			action: SUBSCRIBE_FIRST,
+		},
+		"resub": {
+			msg:    &pb.WebcastSubNotifyMessage{SubMonth: 3, OldSubscribeStatus: pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_RESUB},
+			action: SUBSCRIBE_RENEWAL,
+		},
+		"renewal in grace period": {
+			msg:    &pb.WebcastSubNotifyMessage{SubMonth: 2, OldSubscribeStatus: pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_SUBINGRACEPERIOD},
+			action: SUBSCRIBE_RENEWAL_IN_GRACE,
+		},
+		"renewal after grace period": {
+			msg: &pb.WebcastSubNotifyMessage{SubMonth: 2, OldSubscribeStatus: pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_SUBNOTINGRACEPERIOD,
+				SubscribeType: pb.SubscribeType_SUBSCRIBETYPE_AUTO},
+			action: SUBSCRIBE_RENEWAL_AFTER_GRACE,
+		},
+		"unknown status": {
+			msg:    &pb.WebcastSubNotifyMessage{SubMonth: 5, OldSubscribeStatus: pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_DEFAULT},
+			action: SUBSCRIBE_UNKNOWN,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(tt *testing.T) {
+			test.msg.Common = common()
+			test.msg.User = &pb.User{Id: 2, Nickname: "subscriber"}
+			e, ok := parseTestMsg(tt, test.msg).(SubscribeEvent)
+			if !assert.True(tt, ok) {
+				return
+			}
+			assert.Equal(tt, test.action, e.Action)
+			assert.Equal(tt, int(test.msg.SubMonth), e.Months)
+			assert.Equal(tt, "subscriber", e.User.Nickname)
+		})
+	}
+
+	e := parseTestMsg(t, &pb.WebcastSubNotifyMessage{
+		Common:            common(),
+		SubscribeType:     pb.SubscribeType_SUBSCRIBETYPE_ONCE,
+		SubscribingStatus: pb.SubscribingStatus_SUBSCRIBINGSTATUS_CIRCLECANCEL,
+		IsCustom:          true,
+	}).(SubscribeEvent)
+	assert.Equal(t, SUBSCRIBE_TYPE_ONCE, e.Type)
+	assert.Equal(t, SUBSCRIBE_STATUS_CANCELLED, e.Status)
+	assert.True(t, e.IsCustom)
+	assert.Equal(t, int64(1000), e.CreatedTimestamp())
+}
+
+func TestEmoteChatEvent(t *testing.T) {
+	msg := &pb.WebcastEmoteChatMessage{
+		Common: &pb.Common{Method: "WebcastEmoteChatMessage", MsgId: 1, CreateTime: 1000},
+		User:   &pb.User{Id: 2, Nickname: "subscriber"},
+		EmoteList: []*pb.Emote{
+			{EmoteId: "wave", Image: &pb.Image{UrlList: []string{"https://example.com/wave.webp"}, IsAnimated: true},
+				EmotePrivateType: pb.EmotePrivateType_EMOTE_PRIVATE_TYPE_SUB_WAVE},
+			{EmoteId: "sticker", EmoteType: pb.EmoteType_EMOTETYPEWITHSTICKER},
+		},
+		UserIdentity: &pb.UserIdentity{IsSubscriberOfAnchor: true},
+	}
+	e, ok := parseTestMsg(t, msg).(EmoteChatEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, int64(2), e.User.ID)
+	assert.True(t, e.UserIdentity.IsSubscriber)
+	if assert.Len(t, e.Emotes, 2) {
+		assert.Equal(t, "wave", e.Emotes[0].ID)
+		assert.Equal(t, []string{"https://example.com/wave.webp"}, e.Emotes[0].Image)
+		assert.True(t, e.Emotes[0].Animated)
+		assert.Equal(t, EMOTE_SUBSCRIBER, e.Emotes[0].PrivateType)
+		assert.Equal(t, EMOTE_NORMAL, e.Emotes[0].Type)
+		assert.Equal(t, EMOTE_PUBLIC, e.Emotes[1].PrivateType)
+		assert.Equal(t, EMOTE_STICKER, e.Emotes[1].Type)
+	}
+}
+
+func TestChatEventEmotes(t *testing.T) {
+	msg := chatMessage(1, "hi  there")
+	msg.EmotesList = []*pb.WebcastChatMessage_EmoteWithIndex{{Index: 3, Emote: &pb.Emote{EmoteId: "smile"}}}
+	e, ok := parseTestMsg(t, msg).(ChatEvent)
+	if assert.True(t, ok) && assert.Len(t, e.Emotes, 1) {
+		assert.Equal(t, "smile", e.Emotes[0].ID)
+		assert.Equal(t, 3, e.Emotes[0].Index)
+	}
+}
+
+func TestBarrageEvent(t *testing.T) {
+	msg := &pb.WebcastBarrageMessage{
+		Common:  &pb.Common{Method: "WebcastBarrageMessage", MsgId: 1, CreateTime: 1000},
+		MsgType: pb.WebcastBarrageMessage_FANSLEVELUPGRADE,
+		Icon:    &pb.Image{UrlList: []string{"https://example.com/icon.png"}},
+		Content: &pb.Text{
+			Key:            "pm_mt_fansclub_levelup",
+			DefaultPattern: "{0:user} reached level {1:string} in the fan club",
+			PiecesList: []*pb.Text_TextPiece{
+				{TextPieceType: &pb.Text_TextPiece_UserValue{UserValue: &pb.Text_TextPieceUser{User: &pb.User{Id: 2, Nickname: "fan"}}}},
+				{StringValue: "10"},
+			},
+		},
+		Duration:       3000,
+		FansLevelParam: &pb.WebcastBarrageMessage_BarrageTypeFansLevelParam{CurrentGrade: 10, User: &pb.User{Id: 2, Nickname: "fan"}},
+	}
+	e, ok := parseTestMsg(t, msg).(BarrageEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, BARRAGE_FANS_LEVEL_UPGRADE, e.Type)
+	assert.Equal(t, "fan reached level 10 in the fan club", e.Content.Text)
+	assert.Equal(t, int64(2), e.Content.Pieces[0].User.ID)
+	assert.Equal(t, []string{"https://example.com/icon.png"}, e.Icon)
+	assert.Equal(t, 3*time.Second, e.Duration)
+	assert.Equal(t, 10, e.Grade)
+	assert.Equal(t, int64(2), e.User.ID)
+}
+
+func TestSystemEvents(t *testing.T) {
+	system, ok := parseTestMsg(t, &pb.WebcastSystemMessage{
+		Common:  &pb.Common{Method: "WebcastSystemMessage", MsgId: 1, CreateTime: 1000},
+		Message: "Welcome to LIVE!",
+	}).(SystemEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, "Welcome to LIVE!", system.Message)
+	}
+
+	member, ok := parseTestMsg(t, &pb.WebcastUnauthorizedMemberMessage{
+		Common:         &pb.Common{Method: "WebcastUnauthorizedMemberMessage", MsgId: 2, CreateTime: 1000},
+		Action:         1,
+		NickNamePrefix: &pb.Text{DefaultPattern: "Viewer"},
+		NickName:       "1234",
+		EnterText:      &pb.Text{DefaultPattern: "joined"},
+	}).(UnauthorizedMemberEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, 1, member.Action)
+		assert.Equal(t, "1234", member.Nickname)
+		assert.Equal(t, "Viewer", member.NicknamePrefix.Text)
+		assert.Equal(t, "joined", member.EnterText.Text)
+	}
+
+	verify, ok := parseTestMsg(t, &pb.RoomVerifyMessage{
+		Common:     &pb.Common{Method: "RoomVerifyMessage", MsgId: 3, CreateTime: 1000},
+		Content:    "This LIVE is for adults only",
+		NoticeType: 2,
+		CloseRoom:  true,
+	}).(RoomVerifyEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, "This LIVE is for adults only", verify.Content)
+		assert.Equal(t, int64(2), verify.NoticeType)
+		assert.True(t, verify.CloseRoom)
+	}
+}
+
+func TestDisplayTextEvents(t *testing.T) {
+	text := &pb.Text{
+		DefaultPattern: "{0:user} liked the LIVE",
+		PiecesList: []*pb.Text_TextPiece{
+			{TextPieceType: &pb.Text_TextPiece_UserValue{UserValue: &pb.Text_TextPieceUser{User: &pb.User{Id: 2, Nickname: "fan"}}}},
+		},
+	}
+
+	room, ok := parseTestMsg(t, &pb.WebcastRoomMessage{
+		Common: &pb.Common{Method: "WebcastRoomMessage", MsgId: 1, CreateTime: 1000, DisplayText: text},
+	}).(RoomEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, "fan liked the LIVE", room.Message)
+		assert.Equal(t, int64(2), room.Text.Pieces[0].User.ID)
+	}
+
+	// Without display text the content is used.
+	room, ok = parseTestMsg(t, &pb.WebcastRoomMessage{
+		Common:  &pb.Common{Method: "WebcastRoomMessage", MsgId: 2, CreateTime: 1000},
+		Content: "Welcome to TikTok LIVE!",
+	}).(RoomEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, "Welcome to TikTok LIVE!", room.Message)
+	}
+
+	like, ok := parseTestMsg(t, &pb.WebcastLikeMessage{
+		Common: &pb.Common{Method: "WebcastLikeMessage", MsgId: 3, CreateTime: 1000, DisplayText: text},
+		Count:  5,
+		User:   &pb.User{Id: 2, Nickname: "fan"},
+	}).(LikeEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, "fan liked the LIVE", like.Label)
+		assert.Len(t, like.Text.Segments, 2)
+	}
+}
@@ -1,6 +1,7 @@
 package gotiktoklive
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 )
@@ -31,6 +32,11 @@ func (t *TikTok) NewFeed() *Feed {
 //
 //	to the Feed.LiveStreams list.
 func (f *Feed) Next() (*FeedItem, error) {
+	return f.NextCtx(context.Background())
+}
+
+// NextCtx is Next with a context to cancel the request.
+func (f *Feed) NextCtx(ctx context.Context) (*FeedItem, error) {
 	if !f.HasMore {
 		return nil, ErrNoMoreFeedItems
 	}
@@ -42,7 +48,7 @@ func (f *Feed) Next() (*FeedItem, error) {
 		params["max_time"] = strconv.FormatInt(f.maxTime, 10)
 	}
 
-	body, _, err := f.t.sendRequest(&reqOptions{
+	body, _, err := f.t.sendRequest(ctx, &reqOptions{
 		Endpoint: urlFeed,
 		Query:    params,
 	}, nil)
@@ -70,3 +76,8 @@ func (f *Feed) Next() (*FeedItem, error) {
 func (s *LiveStream) Track() (*Live, error) {
 	return s.t.TrackRoom(s.Rid)
 }
+
+// TrackCtx is Track with a context, see TikTok.TrackRoomCtx.
+func (s *LiveStream) TrackCtx(ctx context.Context) (*Live, error) {
+	return s.t.TrackRoomCtx(ctx, s.Rid)
+}
@@ -0,0 +1,221 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// GoalUpdateEvent is sent when the progress of a LIVE goal changes or a goal is pinned or unpinned. Goal holds the
+// full state of the goal after the update, Live.Goals returns all active goals.
+type GoalUpdateEvent struct {
+	MessageID int64
+	Timestamp int64
+	Goal      *Goal
+	// Contributor is the user whose gift updated the goal, nil when the update has no contributor.
+	Contributor     *User
+	ContributeCount int64
+	ContributeScore int64
+	Pinned          bool
+	Unpinned        bool
+	isHistory       bool
+}
+
+func (g GoalUpdateEvent) IsHistory() bool {
+	return g.isHistory
+}
+
+func (g GoalUpdateEvent) CreatedTimestamp() int64 {
+	return g.Timestamp
+}
+
+// Goal is a LIVE goal made of one or more sub-goals, usually a number of gifts to receive.
+type Goal struct {
+	ID          string
+	Description string
+	// StartTime, ExpireTime and FinishTime are unix timestamps as sent by TikTok, FinishTime is zero until the goal
+	// is reached.
+	StartTime         int64
+	ExpireTime        int64
+	FinishTime        int64
+	TotalCoins        int64
+	TotalContributors int64
+	SubGoals          []SubGoal
+	Contributors      []GoalContributor
+	// Pinned tells if the goal is pinned on the stream, unpinned goals are still active until they expire.
+	Pinned bool
+}
+
+// Done tells if every sub-goal reached its target.
+func (g Goal) Done() bool {
+	if g.FinishTime != 0 {
+		return true
+	}
+	for _, s := range g.SubGoals {
+		if s.Progress < s.Target {
+			return false
+		}
+	}
+	return len(g.SubGoals) > 0
+}
+
+// SubGoal is the progress towards one target of a Goal.
+type SubGoal struct {
+	ID       string
+	Type     int64
+	Progress int64
+	Target   int64
+	// Gift is set when the sub-goal counts a gift.
+	Gift *SubGoalGift
+}
+
+// SubGoalGift is the gift counted by a SubGoal.
+type SubGoalGift struct {
+	Name     string
+	Type     int
+	Diamonds int64
+	Image    []string
+}
+
+// GoalContributor is a top contributor of a Goal.
+type GoalContributor struct {
+	UserID    int64
+	DisplayID string
+	Score     int64
+	Avatar    []string
+}
+
+func toGoalUpdateEvent(pt *pb.WebcastGoalUpdateMessage, isHistory bool) GoalUpdateEvent {
+	e := GoalUpdateEvent{
+		MessageID:       pt.Common.MsgId,
+		Timestamp:       pt.Common.CreateTime,
+		Goal:            toGoal(pt.Goal),
+		ContributeCount: pt.ContributeCount,
+		ContributeScore: pt.ContributeScore,
+		Pinned:          pt.Pin,
+		Unpinned:        pt.Unpin,
+		isHistory:       isHistory,
+	}
+	if pt.ContributorId != 0 || pt.ContributorIdStr != "" {
+		e.Contributor = &User{
+			ID:       pt.ContributorId,
+			Username: pt.ContributorDisplayId,
+			Nickname: pt.ContributorDisplayId,
+		}
+		if e.Contributor.ID == 0 {
+			e.Contributor.ID, _ = strconv.ParseInt(pt.ContributorIdStr, 10, 64)
+		}
+		if pt.ContributorAvatar != nil && pt.ContributorAvatar.UrlList != nil {
+			e.Contributor.ProfilePicture = &ProfilePicture{Urls: pt.ContributorAvatar.UrlList}
+		}
+	}
+	return e
+}
+
+func toGoal(g *pb.Goal) *Goal {
+	if g == nil {
+		return &Goal{}
+	}
+	goal := &Goal{
+		ID:          g.IdStr,
+		Description: g.Description,
+		StartTime:   g.StartTime,
+		ExpireTime:  g.ExpireTime,
+		FinishTime:  g.RealFinishTime,
+	}
+	if goal.ID == "" {
+		goal.ID = strconv.FormatInt(g.Id, 10)
+	}
+	if g.Stats != nil {
+		goal.TotalCoins = g.Stats.TotalCoins
+		goal.TotalContributors = g.Stats.TotalContributor
+	}
+	for _, sub := range g.SubGoalsList {
+		goal.SubGoals = append(goal.SubGoals, toSubGoal(sub))
+	}
+	for _, c := range g.ContributorsList {
+		contributor := GoalContributor{
+			UserID:    c.UserId,
+			DisplayID: c.DisplayId,
+			Score:     c.Score,
+		}
+		if c.Avatar != nil {
+			contributor.Avatar = c.Avatar.UrlList
+		}
+		goal.Contributors = append(goal.Contributors, contributor)
+	}
+	return goal
+}
+
+func toSubGoal(s *pb.Goal_SubGoal) SubGoal {
+	sub := SubGoal{
+		ID:       s.IdStr,
+		Type:     int64(s.Type),
+		Progress: s.Progress,
+		Target:   s.Target,
+	}
+	if sub.ID == "" && s.Id != 0 {
+		sub.ID = strconv.FormatInt(s.Id, 10)
+	}
+	if g := s.Gift; g != nil {
+		sub.Gift = &SubGoalGift{
+			Name:     g.Name,
+			Type:     int(g.Type),
+			Diamonds: g.DiamondCount,
+			Image:    g.GetIcon().GetUrlList(),
+		}
+	}
+	return sub
+}
+
+// goalTracker keeps the latest state of every goal of a live by goal id.
+type goalTracker struct {
+	mu    sync.Mutex
+	goals map[string]Goal
+}
+
+// trackGoal updates the goal from a received event and returns the event with the merged goal state.
+func (l *Live) trackGoal(e GoalUpdateEvent) GoalUpdateEvent {
+	tr := &l.goals
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.goals == nil {
+		tr.goals = make(map[string]Goal)
+	}
+	goal := *e.Goal
+	if old, ok := tr.goals[goal.ID]; ok {
+		goal.Pinned = old.Pinned
+		// Updates do not always carry the sub-goals, keep the last known progress.
+		if len(goal.SubGoals) == 0 {
+			goal.SubGoals = old.SubGoals
+		}
+	}
+	goal.Pinned = (goal.Pinned || e.Pinned) && !e.Unpinned
+	e.Goal = &goal
+	tr.goals[goal.ID] = goal
+	return e
+}
+
+// Goals returns the current progress of the active goals of the live, pinned or not, ordered by start time. Goals
+// that expired are not included.
+func (l *Live) Goals() []Goal {
+	tr := &l.goals
+	now := l.serverNow().Unix()
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	goals := make([]Goal, 0, len(tr.goals))
+	for id, goal := range tr.goals {
+		if goal.ExpireTime > 0 && goal.ExpireTime < now {
+			delete(tr.goals, id)
+			continue
+		}
+		goal.SubGoals = append([]SubGoal(nil), goal.SubGoals...)
+		goals = append(goals, goal)
+	}
+	sort.Slice(goals, func(i, j int) bool {
+		return goals[i].StartTime < goals[j].StartTime
+	})
+	return goals
+}
@@ -0,0 +1,102 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func subGoal(id string, progress, target int64, gift string) *pb.Goal_SubGoal {
+	return &pb.Goal_SubGoal{
+		Type:     1,
+		IdStr:    id,
+		Progress: progress,
+		Target:   target,
+		Gift:     &pb.Goal_SubGoalGift{Name: gift, DiamondCount: 1, Type: 1},
+	}
+}
+
+func goalMessage(msgID int64, progress int64, pin, unpin bool) *pb.WebcastGoalUpdateMessage {
+	goal := &pb.Goal{
+		IdStr:        "goal",
+		Description:  "roses",
+		StartTime:    time.Now().Unix(),
+		ExpireTime:   time.Now().Add(time.Hour).Unix(),
+		Stats:        &pb.Goal_GoalStats{TotalCoins: progress, TotalContributor: 1},
+		SubGoalsList: []*pb.Goal_SubGoal{subGoal("sub", progress, 10, "Rose")},
+	}
+	return &pb.WebcastGoalUpdateMessage{
+		Common:               &pb.Common{Method: "WebcastGoalUpdateMessage", MsgId: msgID, CreateTime: time.Now().UnixMilli()},
+		Goal:                 goal,
+		ContributorIdStr:     "42",
+		ContributorDisplayId: "fan",
+		ContributeCount:      1,
+		ContributeScore:      1,
+		Pin:                  pin,
+		Unpin:                unpin,
+	}
+}
+
+func TestGoalUpdateEvent(t *testing.T) {
+	e, ok := parseTestMsg(t, goalMessage(1, 3, false, false)).(GoalUpdateEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, "goal", e.Goal.ID)
+	assert.Equal(t, "roses", e.Goal.Description)
+	assert.Equal(t, int64(3), e.Goal.TotalCoins)
+	if assert.NotNil(t, e.Contributor) {
+		assert.Equal(t, int64(42), e.Contributor.ID)
+		assert.Equal(t, "fan", e.Contributor.Username)
+	}
+	if assert.Len(t, e.Goal.SubGoals, 1) {
+		sub := e.Goal.SubGoals[0]
+		assert.Equal(t, "sub", sub.ID)
+		assert.Equal(t, int64(3), sub.Progress)
+		assert.Equal(t, int64(10), sub.Target)
+		if assert.NotNil(t, sub.Gift) {
+			assert.Equal(t, "Rose", sub.Gift.Name)
+			assert.Equal(t, int64(1), sub.Gift.Diamonds)
+			assert.Equal(t, 1, sub.Gift.Type)
+		}
+	}
+	assert.False(t, e.Goal.Done())
+}
+
+func TestGoals(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(goalMessage(8001, 0, true, false))))
+	pinned := nextEvent[GoalUpdateEvent](t, live.Events)
+	assert.True(t, pinned.Goal.Pinned)
+
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(goalMessage(8002, 10, false, false))))
+	done := nextEvent[GoalUpdateEvent](t, live.Events)
+	assert.True(t, done.Goal.Pinned, "pin is kept across updates")
+	assert.True(t, done.Goal.Done())
+	if goals := live.Goals(); assert.Len(t, goals, 1) {
+		assert.Equal(t, int64(10), goals[0].SubGoals[0].Progress)
+	}
+
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(goalMessage(8003, 10, false, true))))
+	unpinned := nextEvent[GoalUpdateEvent](t, live.Events)
+	assert.False(t, unpinned.Goal.Pinned)
+	if goals := live.Goals(); assert.Len(t, goals, 1, "unpinned goals stay active") {
+		assert.False(t, goals[0].Pinned)
+	}
+}
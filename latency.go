@@ -0,0 +1,197 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// latencyWindow is the number of latest messages the latency percentiles are computed over.
+const latencyWindow = 1000
+
+// LiveStats are the latency statistics of the messages a Live received on the websocket, to tell delays on TikTok's
+// side from delays in the client.
+type LiveStats struct {
+	// Messages is the number of messages measured since the Live was created, duplicates are not measured.
+	Messages uint64
+	// Latency is how late messages arrive, from TikTok creating a message to it being received on the local clock.
+	Latency LatencyPercentiles
+	// ServerLatency is the part of Latency spent on TikTok's side, from creating a message to the push server
+	// sending it, both on the server clock.
+	ServerLatency LatencyPercentiles
+	// ClockOffset is how far the server clock is ahead of the local clock, a local clock that is off skews Latency.
+	ClockOffset time.Duration
+	// Detect is the last latency probe TikTok sent, nil when none was received.
+	Detect *MsgDetectEvent
+}
+
+// LatencyPercentiles are percentiles of the latency of the latest messages, at most 1000.
+type LatencyPercentiles struct {
+	Samples int
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+	Max     time.Duration
+}
+
+// MsgDetectEvent is a latency probe TikTok sends with the times the message passed its api and push servers.
+type MsgDetectEvent struct {
+	MessageID  int64
+	Timestamp  int64
+	DetectType int
+	TriggerBy  int
+	FromRegion string
+	// ClientStart, APIReceived and APISentToPush are zero when not sent.
+	ClientStart   time.Time
+	APIReceived   time.Time
+	APISentToPush time.Time
+	// UplinkHTTP, UplinkWebSocket, P2PMessages, RoomMessages and HTTPOptimize are the conditions of the probe.
+	UplinkHTTP      bool
+	UplinkWebSocket bool
+	P2PMessages     bool
+	RoomMessages    bool
+	HTTPOptimize    bool
+	isHistory       bool
+}
+
+func (m MsgDetectEvent) IsHistory() bool {
+	return m.isHistory
+}
+
+func (m MsgDetectEvent) CreatedTimestamp() int64 {
+	return m.Timestamp
+}
+
+func toMsgDetectEvent(pt *pb.WebcastMsgDetectMessage, isHistory bool) MsgDetectEvent {
+	e := MsgDetectEvent{
+		MessageID:  pt.Common.MsgId,
+		Timestamp:  pt.Common.CreateTime,
+		DetectType: int(pt.DetectType),
+		TriggerBy:  int(pt.TriggerBy),
+		FromRegion: pt.FromRegion,
+		isHistory:  isHistory,
+	}
+	if t := pt.TimeInfo; t != nil {
+		e.ClientStart = unixMilliOrZero(t.ClientStartMs)
+		e.APIReceived = unixMilliOrZero(t.ApiRecvTimeMs)
+		e.APISentToPush = unixMilliOrZero(t.ApiSendToGoimMs)
+	}
+	if c := pt.TriggerCondition; c != nil {
+		e.UplinkHTTP = c.UplinkDetectHttp
+		e.UplinkWebSocket = c.UplinkDetectWebSocket
+		e.P2PMessages = c.DetectP2PMsg
+		e.RoomMessages = c.DetectRoomMsg
+		e.HTTPOptimize = c.HttpOptimize
+	}
+	return e
+}
+
+func unixMilliOrZero(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// latencyTracker keeps the latency of the latest messages.
+type latencyTracker struct {
+	mu       sync.Mutex
+	messages uint64
+	latency  latencyRing
+	server   latencyRing
+	detect   *MsgDetectEvent
+}
+
+// latencyRing keeps the latest latencyWindow samples.
+type latencyRing struct {
+	samples []time.Duration
+	next    int
+}
+
+func (r *latencyRing) add(d time.Duration) {
+	if len(r.samples) < latencyWindow {
+		r.samples = append(r.samples, d)
+		return
+	}
+	r.samples[r.next] = d
+	r.next = (r.next + 1) % latencyWindow
+}
+
+func (r *latencyRing) percentiles() LatencyPercentiles {
+	p := LatencyPercentiles{Samples: len(r.samples)}
+	if p.Samples == 0 {
+		return p
+	}
+	sorted := append([]time.Duration(nil), r.samples...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	p.P50 = percentile(sorted, 50)
+	p.P90 = percentile(sorted, 90)
+	p.P99 = percentile(sorted, 99)
+	p.Max = sorted[len(sorted)-1]
+	return p
+}
+
+// percentile returns the nearest rank percentile p of sorted.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	i := (len(sorted)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// measureLatency records how late a message created at created was received, serverNow is when the push server sent
+// it on the server clock, 0 when unknown. Messages without a create time are skipped.
+func (l *Live) measureLatency(created int64, received time.Time, serverNow int64) {
+	if created <= 0 {
+		return
+	}
+	tr := &l.latency
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.messages++
+	tr.latency.add(received.Sub(time.UnixMilli(created)))
+	if serverNow > 0 {
+		tr.server.add(time.Duration(serverNow-created) * time.Millisecond)
+	}
+}
+
+// messageCreateTime returns the create time of the common field of a message of any type, 0 when it has none.
+func messageCreateTime(payload []byte) int64 {
+	var m pb.WebcastMessageCommon
+	if err := (proto.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, &m); err != nil {
+		return 0
+	}
+	return m.GetCommon().GetCreateTime()
+}
+
+func (l *Live) trackDetect(e MsgDetectEvent) MsgDetectEvent {
+	tr := &l.latency
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.detect = &e
+	return e
+}
+
+// Stats returns the latency statistics of the messages received so far.
+func (l *Live) Stats() LiveStats {
+	tr := &l.latency
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	stats := LiveStats{
+		Messages:      tr.messages,
+		Latency:       tr.latency.percentiles(),
+		ServerLatency: tr.server.percentiles(),
+		ClockOffset:   time.Duration(l.clockOffset.Load()) * time.Millisecond,
+	}
+	if tr.detect != nil {
+		detect := *tr.detect
+		stats.Detect = &detect
+	}
+	return stats
+}
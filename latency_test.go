@@ -0,0 +1,76 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func TestStats(t *testing.T) {
+	live := &Live{}
+	received := time.UnixMilli(100_000)
+	for i := int64(1); i <= 100; i++ {
+		payload, err := proto.Marshal(&pb.WebcastChatMessage{
+			Common:  &pb.Common{Method: "WebcastChatMessage", MsgId: i, CreateTime: received.UnixMilli() - i*10},
+			Content: "hi",
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		// The server sent every message 5ms after creating it.
+		live.measureLatency(messageCreateTime(payload), received, received.UnixMilli()-i*10+5)
+	}
+	// Messages without a create time are not measured.
+	payload, _ := proto.Marshal(&pb.WebcastChatMessage{Common: &pb.Common{Method: "WebcastChatMessage"}})
+	live.measureLatency(messageCreateTime(payload), received, 0)
+
+	stats := live.Stats()
+	assert.Equal(t, uint64(100), stats.Messages)
+	assert.Equal(t, 100, stats.Latency.Samples)
+	assert.Equal(t, 500*time.Millisecond, stats.Latency.P50)
+	assert.Equal(t, 900*time.Millisecond, stats.Latency.P90)
+	assert.Equal(t, 990*time.Millisecond, stats.Latency.P99)
+	assert.Equal(t, time.Second, stats.Latency.Max)
+	assert.Equal(t, 5*time.Millisecond, stats.ServerLatency.P99)
+	assert.Nil(t, stats.Detect)
+
+	detect, ok := parseTestMsg(t, &pb.WebcastMsgDetectMessage{
+		Common:     &pb.Common{Method: "WebcastMsgDetectMessage", MsgId: 200, CreateTime: 1000},
+		DetectType: 1,
+		FromRegion: "US",
+		TimeInfo:   &pb.WebcastMsgDetectMessage_TimeInfo{ApiRecvTimeMs: 900, ApiSendToGoimMs: 950},
+	}).(MsgDetectEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	live.track(detect)
+	if stats := live.Stats(); assert.NotNil(t, stats.Detect) {
+		assert.Equal(t, "US", stats.Detect.FromRegion)
+		assert.True(t, stats.Detect.ClientStart.IsZero())
+		assert.Equal(t, 50*time.Millisecond, stats.Detect.APISentToPush.Sub(stats.Detect.APIReceived))
+	}
+}
+
+func TestMessageCreateTime(t *testing.T) {
+	// Messages named Header instead of Common have the same common field.
+	payload, err := proto.Marshal(&pb.WebcastLinkmicBattleTaskMessage{Header: &pb.Common{CreateTime: 1234}, Data2: 1})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1234), messageCreateTime(payload))
+	}
+	assert.Zero(t, messageCreateTime([]byte{0xff}))
+}
+
+func TestStatsWindow(t *testing.T) {
+	var r latencyRing
+	for i := 0; i < latencyWindow+10; i++ {
+		r.add(time.Duration(i) * time.Millisecond)
+	}
+	p := r.percentiles()
+	assert.Equal(t, latencyWindow, p.Samples)
+	assert.Equal(t, time.Duration(latencyWindow+9)*time.Millisecond, p.Max)
+	assert.Equal(t, time.Duration(10+latencyWindow/2-1)*time.Millisecond, p.P50)
+}
@@ -2,6 +2,7 @@ package gotiktoklive
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,13 +12,12 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
-	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 
 	pb "github.com/steampoweredtaco/gotiktoklive/proto"
 
@@ -35,13 +35,30 @@ const (
 type Live struct {
 	t *TikTok
 
-	cursor   string
-	wss      net.Conn
-	wsURL    string
-	wsParams map[string]string
-	close    func()
-	done     func() <-chan struct{}
-	cancel   context.CancelFunc
+	cursor      string
+	internalExt string
+	wss         net.Conn
+	wssMu       sync.Mutex
+	wsURL       string
+	wsParams    map[string]string
+	close       func()
+	ctx         context.Context
+	done        func() <-chan struct{}
+	cancel      context.CancelFunc
+	recorder    atomic.Pointer[RecordWriter]
+	replay      bool
+	dedup       *dedup
+	overflow    atomic.Uint64
+	clockOffset atomic.Int64
+	envelopes   envelopeTracker
+	goals       goalTracker
+	polls       pollTracker
+	chat        chatTracker
+	ranks       rankTracker
+	coHosts     coHostTracker
+	battles     battleTracker
+	products    productTracker
+	latency     latencyTracker
 
 	ID       string
 	Info     *RoomInfo
@@ -51,21 +68,30 @@ type Live struct {
 	wg       *sync.WaitGroup
 }
 
-func (t *TikTok) newLive(roomId string) *Live {
+// newLive creates a live that lives until ctx is done or it is closed.
+func (t *TikTok) newLive(ctx context.Context, roomId string) *Live {
 	live := Live{
 		t:        t,
 		ID:       roomId,
 		wg:       &sync.WaitGroup{},
 		Events:   make(chan Event, DEFAULT_EVENTS_CHAN_SIZE),
 		chanSize: DEFAULT_EVENTS_CHAN_SIZE,
+		dedup:    newDedup(t.dedupTTL, t.dedupCapacity),
+		chat:     chatTracker{size: t.chatBuffer},
 	}
+	ctx, cancel := context.WithCancel(ctx)
 	t.mu.Lock()
 	t.streams += 1
+	t.lives[&live] = struct{}{}
 	t.mu.Unlock()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	live.ctx = ctx
 	live.cancel = cancel
 	live.done = ctx.Done
+	// Unblocks the websocket read when the live is cancelled through ctx rather than Close.
+	context.AfterFunc(ctx, live.closeWss)
+	// Lives end with the TikTok instance, see TikTok.Close.
+	stopRoot := context.AfterFunc(t.ctx, cancel)
 	o := sync.Once{}
 	live.close = func() {
 		o.Do(func() {
@@ -74,10 +100,18 @@ func (t *TikTok) newLive(roomId string) *Live {
 			// to call cancel to trigger the other routines, but calls to close is only for
 			// cleanup and block till done
 			cancel()
-			live.wss.Close()
+			live.closeWss()
+			live.stopTrackers()
 			live.wg.Wait()
+			if recorder := live.recorder.Swap(nil); recorder != nil {
+				if err := recorder.Close(); err != nil {
+					t.warnHandler(fmt.Errorf("failed to close recording: %w", err))
+				}
+			}
+			stopRoot()
 			t.mu.Lock()
 			t.streams -= 1
+			delete(t.lives, &live)
 			t.mu.Unlock()
 		})
 	}
@@ -90,20 +124,20 @@ func (l *Live) Close() {
 	l.close()
 }
 
-func (l *Live) fetchRoom() error {
-	roomInfo, err := l.getRoomInfo()
+func (l *Live) fetchRoom(ctx context.Context) error {
+	roomInfo, err := l.getRoomInfo(ctx)
 	if err != nil {
 		return err
 	}
 	l.Info = roomInfo
 	//
-	// giftInfo, err := l.getGiftInfo()
+	// giftInfo, err := l.getGiftInfo(ctx)
 	// if err != nil {
 	//	return err
 	// }
 	// l.GiftInfo = giftInfo
 
-	err = l.getRoomData()
+	err = l.getRoomData(ctx)
 	if err != nil {
 		return err
 	}
@@ -114,7 +148,12 @@ func (l *Live) fetchRoom() error {
 //
 //	but not start tracking a live stream.
 func (t *TikTok) GetRoomInfo(username string) (*RoomInfo, error) {
-	id, err := t.getRoomID(username)
+	return t.GetRoomInfoCtx(context.Background(), username)
+}
+
+// GetRoomInfoCtx is GetRoomInfo with a context to cancel the requests.
+func (t *TikTok) GetRoomInfoCtx(ctx context.Context, username string) (*RoomInfo, error) {
+	id, err := t.getRoomID(ctx, username)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to fetch room ID by username")
 	}
@@ -124,7 +163,7 @@ func (t *TikTok) GetRoomInfo(username string) (*RoomInfo, error) {
 		ID: id,
 	}
 
-	roomInfo, err := l.getRoomInfo()
+	roomInfo, err := l.getRoomInfo(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to fetch room info")
 	}
@@ -138,25 +177,41 @@ func (t *TikTok) GetRoomInfo(username string) (*RoomInfo, error) {
 //
 // It will start a go routine and connect to the tiktok websocket.
 func (t *TikTok) TrackUser(username string) (*Live, error) {
-	id, err := t.getRoomID(username)
+	return t.TrackUserCtx(context.Background(), username)
+}
+
+// TrackUserCtx is TrackUser with a context, see TrackRoomCtx.
+func (t *TikTok) TrackUserCtx(ctx context.Context, username string) (*Live, error) {
+	id, err := t.getRoomID(ctx, username)
 	if err != nil {
 		return nil, err
 	}
 
-	return t.TrackRoom(id)
+	return t.TrackRoomCtx(ctx, id)
 }
 
 // TrackRoom will start to track a room by room ID.
 // It will start a go routine and connect to the tiktok websocket.
 func (t *TikTok) TrackRoom(roomId string) (*Live, error) {
-	live := t.newLive(roomId)
+	return t.TrackRoomCtx(context.Background(), roomId)
+}
+
+// TrackRoomCtx is TrackRoom with a context. ctx cancels fetching the room and dialing the websocket, and stays tied
+// to the returned Live: when ctx is done the Live stops, including reconnects, as if Close was called.
+func (t *TikTok) TrackRoomCtx(ctx context.Context, roomId string) (*Live, error) {
+	live := t.newLive(ctx, roomId)
+	if err := live.startRecording(); err != nil {
+		t.warnHandler(fmt.Errorf("recording disabled for room %s: %w", roomId, err))
+	}
 
-	if err := live.fetchRoom(); err != nil {
+	if err := live.fetchRoom(live.ctx); err != nil {
+		live.close()
 		close(live.Events)
 		return nil, err
 	}
 
 	if err := live.connectRoom(); err != nil {
+		live.close()
 		return nil, err
 	}
 
@@ -167,8 +222,8 @@ func (live *Live) connectRoom() error {
 	return live.tryConnectionUpgrade()
 }
 
-func (t *TikTok) getRoomID(user string) (string, error) {
-	userInfo, err := t.GetUserInfo(user)
+func (t *TikTok) getRoomID(ctx context.Context, user string) (string, error) {
+	userInfo, err := t.GetUserInfoCtx(ctx, user)
 	if err != nil {
 		return "", err
 	}
@@ -179,20 +234,25 @@ func (t *TikTok) getRoomID(user string) (string, error) {
 	return userInfo.RoomID, nil
 }
 
-func (l *Live) getRoomInfo() (*RoomInfo, error) {
+func (l *Live) getRoomInfo(ctx context.Context) (*RoomInfo, error) {
 	t := l.t
 
 	params := copyMap(defaultGETParams)
 	params["room_id"] = l.ID
 
-	body, _, err := t.sendRequest(&reqOptions{
+	body, _, err := t.sendRequest(ctx, &reqOptions{
 		Endpoint: urlRoomInfo,
 		Query:    params,
 	}, nil)
 	if err != nil {
 		return nil, err
 	}
+	l.record(RecordRoomInfo, body)
+
+	return l.parseRoomInfo(body)
+}
 
+func (l *Live) parseRoomInfo(body []byte) (*RoomInfo, error) {
 	var rsp roomInfoRsp
 	if err := json.Unmarshal(body, &rsp); err != nil {
 		return nil, err
@@ -204,13 +264,13 @@ func (l *Live) getRoomInfo() (*RoomInfo, error) {
 	return rsp.RoomInfo, nil
 }
 
-func (l *Live) getGiftInfo() (*GiftInfo, error) {
+func (l *Live) getGiftInfo(ctx context.Context) (*GiftInfo, error) {
 	t := l.t
 
 	params := copyMap(defaultGETParams)
 	params["room_id"] = l.ID
 
-	body, _, err := t.sendRequest(&reqOptions{
+	body, _, err := t.sendRequest(ctx, &reqOptions{
 		Endpoint: urlGiftInfo,
 		Query:    params,
 	}, nil)
@@ -225,7 +285,7 @@ func (l *Live) getGiftInfo() (*GiftInfo, error) {
 	return rsp.GiftInfo, nil
 }
 
-func (l *Live) getRoomData() error {
+func (l *Live) getRoomData(ctx context.Context) error {
 	t := l.t
 
 	params := copyMap(defaultGETParams)
@@ -234,28 +294,42 @@ func (l *Live) getRoomData() error {
 	if l.cursor != "" {
 		params["cursor"] = l.cursor
 	}
+	if l.internalExt != "" {
+		params["internal_ext"] = l.internalExt
+	}
 
-	body, headers, err := t.sendRequest(&reqOptions{
+	body, headers, err := t.sendRequest(ctx, &reqOptions{
 		Endpoint: urlRoomData,
 		Query:    params,
 	}, nil)
 	if err != nil {
 		return err
 	}
-	ttsCookie := headers.Get("X-Set-TT-Cookie")
-	cookies, err := http.ParseCookie(ttsCookie)
-	if err != nil {
-		return fmt.Errorf("X-SetTT-Cookie not parsable: %w", err)
-	}
+	// Signers other than the default might not pass along the cookie
+	if ttsCookie := headers.Get("X-Set-TT-Cookie"); ttsCookie != "" {
+		cookies, err := http.ParseCookie(ttsCookie)
+		if err != nil {
+			return fmt.Errorf("X-SetTT-Cookie not parsable: %w", err)
+		}
 
-	for i := range cookies {
-		cookies[i].Domain = ".tiktok.com"
-	}
-	u, err := url.Parse("https://tiktok.com")
-	if err != nil {
-		return fmt.Errorf("semantica error couldnot parse secure cookie endpoint, please report: %w", err)
+		for i := range cookies {
+			cookies[i].Domain = ".tiktok.com"
+		}
+		u, err := url.Parse("https://tiktok.com")
+		if err != nil {
+			return fmt.Errorf("semantica error couldnot parse secure cookie endpoint, please report: %w", err)
+		}
+		t.c.Jar.SetCookies(u, cookies)
 	}
-	t.c.Jar.SetCookies(u, cookies)
+	l.record(RecordRoomData, body)
+
+	return l.parseRoomData(body)
+}
+
+// parseRoomData handles the fetched WebcastResponse, keeping the cursor and push server for connecting and sending
+// the initial messages upstream.
+func (l *Live) parseRoomData(body []byte) error {
+	t := l.t
 
 	var rsp pb.WebcastResponse
 	if err := proto.Unmarshal(body, &rsp); err != nil {
@@ -263,6 +337,8 @@ func (l *Live) getRoomData() error {
 	}
 
 	l.cursor = rsp.Cursor
+	l.internalExt = string(rsp.InternalExt)
+	l.syncClock(rsp.Now)
 	if rsp.PushServer != "" && rsp.RouteParamsMap != nil {
 		l.wsURL = rsp.PushServer
 		l.wsParams = make(map[string]string)
@@ -273,6 +349,10 @@ func (l *Live) getRoomData() error {
 	}
 
 	for _, msg := range rsp.Messages {
+		// Room data fetched on reconnect repeats messages that were already received.
+		if l.dedup.duplicate(msg.MsgId) {
+			continue
+		}
 		parsed, err := parseMsg(msg, t.warnHandler, t.debugHandler, t.enableExperimentalEvents)
 		if err != nil {
 			return err
@@ -282,7 +362,9 @@ func (l *Live) getRoomData() error {
 			// but can cause problems if we send the events upstream
 			continue
 		}
-		l.Events <- parsed
+		for _, e := range l.track(parsed) {
+			l.sendEvent(e)
+		}
 	}
 
 	return nil
@@ -336,20 +418,24 @@ func (l *Live) DownloadStream(file ...string) error {
 
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
+		cancel()
 		return err
 	}
 
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
+		cancel()
 		return err
 	}
 
 	stderr, err := cmd.StderrPipe()
 	if err != nil {
+		cancel()
 		return err
 	}
 
 	if err := cmd.Start(); err != nil {
+		cancel()
 		return err
 	}
 
@@ -425,8 +511,8 @@ func (l *Live) DownloadStream(file ...string) error {
 		finished = true
 		if err != nil {
 			l.t.errHandler(fmt.Sprintf("Download for failed: %s", err))
+			return
 		}
-		return
 		l.t.infoHandler(fmt.Sprintf("Download for %s finished!", l.Info.Owner.Username))
 	}(cmd, stdout, stderr)
 	l.wg.Wait()
@@ -435,32 +521,6 @@ func (l *Live) DownloadStream(file ...string) error {
 	return nil
 }
 
-func (t *TikTok) signURL(reqUrl string, options *reqOptions) ([]byte, http.Header, error) {
-	query := map[string]string{
-		"client":  t.clientName,
-		"uuc":     strconv.Itoa(t.streams),
-		"url":     reqUrl,
-		"room_id": options.Query["room_id"],
-	}
-	if t.apiKey != "" {
-		query["apiKey"] = t.apiKey
-	}
-	// A badly formed implementation using this library might spam connection requests (ask me
-	// how I know) this limiter is a safety guard to never go over the signer's advertised
-	// capabilities so the client does not exceed limits or get banned from the signer.
-	t.limiter.Take()
-	body, header, err := t.sendRequest(&reqOptions{
-		URI:      t.signerUrl,
-		Endpoint: urlSignReq,
-		Query:    query,
-	}, nil)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, fmt.Sprintf("Failed to sign request: %s", body))
-	}
-
-	return body, header, nil
-}
-
 // Only able to get this while logged in
 // func (l *Live) GetRankList() (*RankList, error) {
 // 	t := l.t
@@ -470,7 +530,7 @@ func (t *TikTok) signURL(reqUrl string, options *reqOptions) ([]byte, http.Heade
 // 	params["channel"] = "tiktok_web"
 // 	params["anchor_id"] = "idk"
 //
-// 	body, err := t.sendRequest(&reqOptions{
+// 	body, err := t.sendRequest(ctx, &reqOptions{
 // 		Endpoint: urlRankList,
 // 		Query:    params,
 // 	})
@@ -0,0 +1,157 @@
+package gotiktoklive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func newTestTikTok(t *testing.T, srv *webcasttest.Server, options ...TikTokLiveOption) *TikTok {
+	t.Helper()
+	options = append([]TikTokLiveOption{
+		TikTokUrl(srv.TikTokURL()),
+		WebcastUrl(srv.WebcastURL()),
+		SigningUrl(srv.SignerURL()),
+	}, options...)
+	tiktok, err := NewTikTok(options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tiktok.pingInterval = 50 * time.Millisecond
+	return tiktok
+}
+
+func chatMessage(msgID int64, content string) *pb.WebcastChatMessage {
+	return &pb.WebcastChatMessage{
+		Common:  &pb.Common{Method: "WebcastChatMessage", MsgId: msgID, CreateTime: time.Now().UnixMilli()},
+		User:    &pb.User{Id: 1, Nickname: "viewer", IdStr: "viewer"},
+		Content: content,
+	}
+}
+
+// nextEvent waits for the next event of type T, skipping any other events.
+func nextEvent[T Event](t *testing.T, events <-chan Event) T {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				t.Fatalf("events closed waiting for %T", *new(T))
+			}
+			if te, ok := e.(T); ok {
+				return te
+			}
+		case <-timeout:
+			t.Fatalf("timeout waiting for %T", *new(T))
+		}
+	}
+}
+
+func TestTrackUserOffline(t *testing.T) {
+	srv := webcasttest.NewServer(webcasttest.WithRoomMessages(webcasttest.NewMessage(chatMessage(1001, "from room data"))))
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	live, err := tiktok.TrackUser(srv.Username())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	assert.Equal(t, srv.RoomID(), live.ID)
+	assert.Equal(t, srv.Username(), live.Info.Owner.Username)
+
+	chat := nextEvent[ChatEvent](t, live.Events)
+	assert.Equal(t, "from room data", chat.Comment)
+
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+	if !assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(chatMessage(1002, "from websocket")))) {
+		return
+	}
+	chat = nextEvent[ChatEvent](t, live.Events)
+	assert.Equal(t, "from websocket", chat.Comment)
+	assert.Equal(t, "viewer", chat.User.Nickname)
+
+	assert.Eventually(t, func() bool { return len(srv.Acks()) == 1 }, 5*time.Second, 10*time.Millisecond, "ack")
+	assert.Eventually(t, func() bool { return srv.Pings() > 0 }, 5*time.Second, 10*time.Millisecond, "ping")
+}
+
+func TestTrackUserOfflineEnded(t *testing.T) {
+	srv := webcasttest.NewServer(webcasttest.WithEnded())
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	_, err := tiktok.TrackUser(srv.Username())
+	assert.ErrorIs(t, err, ErrUserOffline)
+}
+
+func TestLiveReconnect(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv, ReconnectBackoff(10*time.Millisecond, 50*time.Millisecond, 3))
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	srv.DropConnections()
+	reconnecting := nextEvent[ReconnectingEvent](t, live.Events)
+	assert.Equal(t, 1, reconnecting.Attempt)
+	reconnected := nextEvent[ReconnectedEvent](t, live.Events)
+	assert.Equal(t, 1, reconnected.Attempts)
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	cursors := srv.FetchCursors()
+	if assert.Len(t, cursors, 2) {
+		assert.Empty(t, cursors[0])
+		assert.NotEmpty(t, cursors[1], "reconnect should resume from the saved cursor")
+	}
+
+	if !assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(chatMessage(2001, "after reconnect")))) {
+		return
+	}
+	chat := nextEvent[ChatEvent](t, live.Events)
+	assert.Equal(t, "after reconnect", chat.Comment)
+}
+
+func TestTrackRoomCtx(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := tiktok.TrackRoomCtx(ctx, srv.RoomID())
+	assert.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	live, err := tiktok.TrackRoomCtx(ctx, srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	cancel()
+	nextEvent[*DisconnectEvent](t, live.Events)
+	_, ok := <-live.Events
+	assert.False(t, ok, "events should be closed once the context is done")
+	assert.Equal(t, 1, srv.Connections(), "no reconnect after the context is done")
+}
@@ -1,6 +1,12 @@
 package gotiktoklive
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
 
 type TikTokLiveOption func(t *TikTok) error
 
@@ -21,6 +27,65 @@ func SigningUrl(url string) TikTokLiveOption {
 	}
 }
 
+// TikTokUrl overrides the TikTok website base url, the default is https://www.tiktok.com/. The url must end with a
+// slash. This is mostly useful to point the library at a fake server such as the one in the webcasttest package.
+func TikTokUrl(url string) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if !strings.HasSuffix(url, "/") {
+			return fmt.Errorf("tiktok url %s must end with a /", url)
+		}
+		t.baseUrl = url
+		return nil
+	}
+}
+
+// WebcastUrl overrides the webcast api url, the default is https://webcast.tiktok.com/webcast/. The url must end with
+// a slash.
+func WebcastUrl(url string) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if !strings.HasSuffix(url, "/") {
+			return fmt.Errorf("webcast url %s must end with a /", url)
+		}
+		t.apiUrl = url
+		return nil
+	}
+}
+
+// WithSigner replaces the default signer, see Signer. When set, SigningUrl and SigningApiKey are not used by the
+// library.
+func WithSigner(signer Signer) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if signer == nil {
+			return fmt.Errorf("signer cannot be nil")
+		}
+		t.signer = signer
+		return nil
+	}
+}
+
+// SigningEndpoints sets an ordered list of signers that support the signing api as defined by
+// https://www.eulerstream.com/docs/openapi. Each endpoint gets its own rate limit from its advertised limits. Signing
+// fails over to the next endpoint when one is rate limited, answers with a 5xx status, blocks the IP or times out,
+// and a failing endpoint is skipped for a minute.
+func SigningEndpoints(endpoints ...SignerEndpoint) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if len(endpoints) == 0 {
+			return fmt.Errorf("at least one signing endpoint is required")
+		}
+		signers := make([]Signer, 0, len(endpoints))
+		for _, endpoint := range endpoints {
+			signer := NewEulerSigner(endpoint.URL, endpoint.ApiKey)
+			signer.timeout = endpoint.Timeout
+			if signer.timeout == 0 {
+				signer.timeout = defaultSignerTimeout
+			}
+			signers = append(signers, signer)
+		}
+		t.signer = NewFailoverSigner(defaultSignerCooldown, signers...)
+		return nil
+	}
+}
+
 // DisableSigningLimitsValidation will disable querying the signer for limits and using those as the reasonable limits
 // for signing requests per second. Instead, this library will be limited to signing only 5 signing requests per minute
 // and may limit functionality compared to the request limit the signer provides.
@@ -68,3 +133,74 @@ func Proxy(url string, insecure bool) TikTokLiveOption {
 		return t.setProxy(url, insecure)
 	}
 }
+
+// DisableReconnect stops a Live from reconnecting to the websocket when the connection is lost. Instead, a
+// DisconnectEvent is emitted and the Events channel is closed on the first read error.
+func DisableReconnect(t *TikTok) error {
+	t.shouldReconnect = false
+	return nil
+}
+
+// ReconnectBackoff configures the exponential backoff used when a Live reconnects after losing the websocket. The
+// first attempt waits initial, each following attempt doubles the wait up to max. After maxAttempts failed attempts
+// the Live gives up and emits a DisconnectEvent, a maxAttempts of 0 retries until the Live is closed.
+func ReconnectBackoff(initial, max time.Duration, maxAttempts int) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if initial <= 0 || max < initial {
+			return fmt.Errorf("invalid reconnect backoff %s-%s", initial, max)
+		}
+		if maxAttempts < 0 {
+			return fmt.Errorf("invalid reconnect attempts %d", maxAttempts)
+		}
+		t.reconnectDelay = initial
+		t.reconnectMaxDelay = max
+		t.reconnectMaxAttempts = maxAttempts
+		return nil
+	}
+}
+
+// EnableRecording records every tracked Live into dir, which is created if needed. Each Live gets its own
+// <room id>-<start time>.ttrec file with the raw room data and websocket frames, see ReplayLive to play them back.
+func EnableRecording(dir string) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("cannot create recording directory: %w", err)
+		}
+		t.recordDir = dir
+		return nil
+	}
+}
+
+// EnableInterruptHandler restores the old behaviour of closing the TikTok instance and exiting the process with os.Exit
+// on SIGINT or SIGTERM. Without it the library leaves signals to the application, which should call TikTok.Close.
+func EnableInterruptHandler(t *TikTok) error {
+	t.handleInterrupts = true
+	return nil
+}
+
+// EnableChatBuffer keeps the last size comments of each Live, see Live.RecentChat. Comments deleted by a moderator,
+// by message id or along with all messages of their user, are removed from the buffer and reported in the Removed
+// field of the ChatDeleteEvent.
+func EnableChatBuffer(size int) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid chat buffer size %d", size)
+		}
+		t.chatBuffer = size
+		return nil
+	}
+}
+
+// MessageDedup configures how each Live drops messages it already received, such as the messages repeated by the room
+// data fetched after a reconnect. Message ids are remembered for ttl, at most capacity of them, the default is 15
+// minutes and 10000 ids. A ttl of 0 disables de-duplication. Dropped messages are counted by Live.Dropped.
+func MessageDedup(ttl time.Duration, capacity int) TikTokLiveOption {
+	return func(t *TikTok) error {
+		if ttl < 0 || (ttl > 0 && capacity <= 0) {
+			return fmt.Errorf("invalid message de-duplication %s with capacity %d", ttl, capacity)
+		}
+		t.dedupTTL = ttl
+		t.dedupCapacity = capacity
+		return nil
+	}
+}
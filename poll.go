@@ -0,0 +1,243 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"sync"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// PollAction tells which part of the lifecycle of a poll a PollEvent is about.
+type PollAction string
+
+const (
+	POLL_START  PollAction = "poll started"
+	POLL_UPDATE PollAction = "poll votes updated"
+	POLL_END    PollAction = "poll ended"
+)
+
+// PollEvent is sent when a poll starts, when its votes change and when it ends. Poll holds the full state of the poll
+// after the event, updates only carry the votes so the title and option texts are filled in from the start of the
+// poll when it was seen. Live.Polls returns all polls of the live.
+type PollEvent struct {
+	MessageID int64
+	Timestamp int64
+	Action    PollAction
+	Poll      *Poll
+	// Operator is the user that started or ended the poll, nil for updates.
+	Operator  *User
+	isHistory bool
+}
+
+func (p PollEvent) IsHistory() bool {
+	return p.isHistory
+}
+
+func (p PollEvent) CreatedTimestamp() int64 {
+	return p.Timestamp
+}
+
+// Poll is an in-stream poll.
+type Poll struct {
+	ID    int64
+	Title string
+	// StartTime and EndTime are unix timestamps as sent by TikTok.
+	StartTime int64
+	EndTime   int64
+	Options   []PollOption
+	Ended     bool
+	EndType   int
+}
+
+// PollOption is one of the answers of a poll with its current votes.
+type PollOption struct {
+	Index int
+	Text  string
+	Votes int
+	// Voters are the voters TikTok sends along with the votes, usually only a few of them.
+	Voters []*User
+}
+
+// Winners returns the options with the most votes, more than one on a tie and none when nobody voted.
+func (p Poll) Winners() []PollOption {
+	var winners []PollOption
+	most := 0
+	for _, o := range p.Options {
+		switch {
+		case o.Votes > most:
+			most = o.Votes
+			winners = []PollOption{o}
+		case o.Votes == most && most > 0:
+			winners = append(winners, o)
+		}
+	}
+	return winners
+}
+
+// TotalVotes is the sum of the votes of all options.
+func (p Poll) TotalVotes() int {
+	total := 0
+	for _, o := range p.Options {
+		total += o.Votes
+	}
+	return total
+}
+
+func toPollEvent(pt *pb.WebcastPollMessage, isHistory bool) PollEvent {
+	e := PollEvent{
+		MessageID: pt.Common.MsgId,
+		Timestamp: pt.Common.CreateTime,
+		Poll:      &Poll{ID: pt.PollId},
+		isHistory: isHistory,
+	}
+	switch {
+	case pt.StartContent != nil:
+		e.Action = POLL_START
+		e.Poll.Title = pt.StartContent.Title
+		e.Poll.StartTime = pt.StartContent.StartTime
+		e.Poll.EndTime = pt.StartContent.EndTime
+		e.Poll.Options = toPollOptions(pt.StartContent.OptionList)
+		if pt.StartContent.Operator != nil {
+			e.Operator = toUser(pt.StartContent.Operator)
+		}
+	case pt.EndContent != nil:
+		e.Action = POLL_END
+		e.Poll.Ended = true
+		e.Poll.EndType = int(pt.EndContent.EndType)
+		e.Poll.Options = toPollOptions(pt.EndContent.OptionList)
+		if pt.EndContent.Operator != nil {
+			e.Operator = toUser(pt.EndContent.Operator)
+		}
+	default:
+		e.Action = POLL_UPDATE
+		e.Poll.Options = toPollOptions(pt.GetUpdateContent().GetOptionList())
+	}
+	return e
+}
+
+func toPollOptions(options []*pb.PollOptionInfo) []PollOption {
+	var out []PollOption
+	for _, o := range options {
+		option := PollOption{
+			Index: int(o.OptionIdx),
+			Text:  o.DisplayContent,
+			Votes: int(o.Votes),
+		}
+		for _, v := range o.VoteUserList {
+			voter := &User{ID: v.UserId, Username: v.NickName, Nickname: v.NickName}
+			if v.AvatarThumb != nil && v.AvatarThumb.UrlList != nil {
+				voter.ProfilePicture = &ProfilePicture{Urls: v.AvatarThumb.UrlList}
+			}
+			option.Voters = append(option.Voters, voter)
+		}
+		out = append(out, option)
+	}
+	return out
+}
+
+// pollTracker keeps the state of every poll of a live by poll id.
+type pollTracker struct {
+	mu    sync.Mutex
+	polls map[int64]*Poll
+}
+
+// trackPoll merges the poll of a received event into the known state and returns the event with the merged poll.
+func (l *Live) trackPoll(e PollEvent) PollEvent {
+	tr := &l.polls
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.polls == nil {
+		tr.polls = make(map[int64]*Poll)
+	}
+	known, ok := tr.polls[e.Poll.ID]
+	if !ok {
+		known = &Poll{ID: e.Poll.ID}
+		tr.polls[e.Poll.ID] = known
+	}
+	if e.Action == POLL_START {
+		known.Title = e.Poll.Title
+		known.StartTime = e.Poll.StartTime
+		known.EndTime = e.Poll.EndTime
+	}
+	if e.Action == POLL_END {
+		known.Ended = true
+		known.EndType = e.Poll.EndType
+	}
+	known.Options = mergePollOptions(known.Options, e.Poll.Options)
+	e.Poll = known.clone()
+	return e
+}
+
+// mergePollOptions updates the votes of the known options, keeping their texts and all voters seen so far.
+func mergePollOptions(known, update []PollOption) []PollOption {
+	byIndex := make(map[int]int, len(known))
+	for i, o := range known {
+		byIndex[o.Index] = i
+	}
+	for _, o := range update {
+		i, ok := byIndex[o.Index]
+		if !ok {
+			byIndex[o.Index] = len(known)
+			known = append(known, o)
+			continue
+		}
+		if o.Text != "" {
+			known[i].Text = o.Text
+		}
+		known[i].Votes = o.Votes
+		seen := make(map[int64]bool, len(known[i].Voters))
+		for _, v := range known[i].Voters {
+			seen[v.ID] = true
+		}
+		for _, v := range o.Voters {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				known[i].Voters = append(known[i].Voters, v)
+			}
+		}
+	}
+	sort.Slice(known, func(i, j int) bool {
+		return known[i].Index < known[j].Index
+	})
+	return known
+}
+
+func (p *Poll) clone() *Poll {
+	c := *p
+	c.Options = make([]PollOption, len(p.Options))
+	for i, o := range p.Options {
+		o.Voters = append([]*User(nil), o.Voters...)
+		c.Options[i] = o
+	}
+	return &c
+}
+
+// Polls returns the polls of the live, running and ended, ordered by start time.
+func (l *Live) Polls() []Poll {
+	tr := &l.polls
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	polls := make([]Poll, 0, len(tr.polls))
+	for _, p := range tr.polls {
+		polls = append(polls, *p.clone())
+	}
+	sort.Slice(polls, func(i, j int) bool {
+		if polls[i].StartTime != polls[j].StartTime {
+			return polls[i].StartTime < polls[j].StartTime
+		}
+		return polls[i].ID < polls[j].ID
+	})
+	return polls
+}
+
+// Poll returns the poll with the given id, false when the poll was not seen.
+func (l *Live) Poll(id int64) (Poll, bool) {
+	tr := &l.polls
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	p, ok := tr.polls[id]
+	if !ok {
+		return Poll{}, false
+	}
+	return *p.clone(), true
+}
@@ -0,0 +1,86 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func pollMessage(msgID int64) *pb.WebcastPollMessage {
+	return &pb.WebcastPollMessage{
+		Common: &pb.Common{Method: "WebcastPollMessage", MsgId: msgID, CreateTime: time.Now().UnixMilli()},
+		PollId: 99,
+	}
+}
+
+func TestPollLifecycle(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+
+	start := pollMessage(9001)
+	start.StartContent = &pb.PollStartContent{
+		StartTime: time.Now().Unix(),
+		EndTime:   time.Now().Add(time.Minute).Unix(),
+		Title:     "Cats or dogs?",
+		OptionList: []*pb.PollOptionInfo{
+			{OptionIdx: 0, DisplayContent: "Cats"},
+			{OptionIdx: 1, DisplayContent: "Dogs"},
+		},
+		Operator: &pb.User{Id: 1, Nickname: "host"},
+	}
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(start)))
+	started := nextEvent[PollEvent](t, live.Events)
+	assert.Equal(t, POLL_START, started.Action)
+	assert.Equal(t, "Cats or dogs?", started.Poll.Title)
+	assert.Len(t, started.Poll.Options, 2)
+	assert.Equal(t, "host", started.Operator.Nickname)
+
+	update := pollMessage(9002)
+	update.UpdateContent = &pb.PollUpdateVotesContent{OptionList: []*pb.PollOptionInfo{
+		{OptionIdx: 1, Votes: 2, VoteUserList: []*pb.VoteUser{{UserId: 10, NickName: "a"}}},
+		{OptionIdx: 0, Votes: 1, VoteUserList: []*pb.VoteUser{{UserId: 11, NickName: "b"}}},
+	}}
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(update)))
+	updated := nextEvent[PollEvent](t, live.Events)
+	assert.Equal(t, POLL_UPDATE, updated.Action)
+	assert.Equal(t, "Cats or dogs?", updated.Poll.Title, "title kept from the start")
+	if assert.Len(t, updated.Poll.Options, 2) {
+		assert.Equal(t, "Cats", updated.Poll.Options[0].Text)
+		assert.Equal(t, 1, updated.Poll.Options[0].Votes)
+		assert.Equal(t, 2, updated.Poll.Options[1].Votes)
+	}
+
+	end := pollMessage(9003)
+	end.EndContent = &pb.PollEndContent{EndType: 1, OptionList: []*pb.PollOptionInfo{
+		{OptionIdx: 0, Votes: 1},
+		{OptionIdx: 1, Votes: 3, VoteUserList: []*pb.VoteUser{{UserId: 12, NickName: "c"}}},
+	}}
+	assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(end)))
+	ended := nextEvent[PollEvent](t, live.Events)
+	assert.Equal(t, POLL_END, ended.Action)
+	assert.True(t, ended.Poll.Ended)
+	assert.Equal(t, 4, ended.Poll.TotalVotes())
+	if winners := ended.Poll.Winners(); assert.Len(t, winners, 1) {
+		assert.Equal(t, "Dogs", winners[0].Text)
+		assert.Len(t, winners[0].Voters, 2, "voters of all updates")
+	}
+
+	poll, ok := live.Poll(99)
+	assert.True(t, ok)
+	assert.True(t, poll.Ended)
+	assert.Len(t, live.Polls(), 1)
+}
@@ -2454,9 +2454,9 @@ type Goal struct {
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// TextType type = 2; // Enum
 	// GoalStatus status = 3; // Enum
-	// repeated SubGoal subGoalsList = 4;
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	AuditStatus int32  `protobuf:"varint,6,opt,name=auditStatus,proto3" json:"auditStatus,omitempty"`
+	SubGoalsList []*Goal_SubGoal `protobuf:"bytes,4,rep,name=subGoalsList,proto3" json:"subGoalsList,omitempty"`
+	Description  string          `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	AuditStatus  int32           `protobuf:"varint,6,opt,name=auditStatus,proto3" json:"auditStatus,omitempty"`
 	// CycleType cycleType = 7; // Enum
 	StartTime          int64                   `protobuf:"varint,8,opt,name=startTime,proto3" json:"startTime,omitempty"`
 	ExpireTime         int64                   `protobuf:"varint,9,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
@@ -2507,6 +2507,13 @@ func (x *Goal) GetId() int64 {
 	return 0
 }
 
+func (x *Goal) GetSubGoalsList() []*Goal_SubGoal {
+	if x != nil {
+		return x.SubGoalsList
+	}
+	return nil
+}
+
 func (x *Goal) GetDescription() string {
 	if x != nil {
 		return x.Description
@@ -7636,8 +7643,8 @@ type Text_TextPieceGift struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GiftId int32 `protobuf:"varint,1,opt,name=giftId,proto3" json:"giftId,omitempty"`
-	// PatternRef nameRef = 2;
+	GiftId  int32                     `protobuf:"varint,1,opt,name=giftId,proto3" json:"giftId,omitempty"`
+	NameRef *Text_TextPiecePatternRef `protobuf:"bytes,2,opt,name=nameRef,proto3" json:"nameRef,omitempty"`
 	// ShowType showType = 3; // Enum
 	ColorId int64 `protobuf:"varint,4,opt,name=colorId,proto3" json:"colorId,omitempty"`
 }
@@ -7681,6 +7688,13 @@ func (x *Text_TextPieceGift) GetGiftId() int32 {
 	return 0
 }
 
+func (x *Text_TextPieceGift) GetNameRef() *Text_TextPiecePatternRef {
+	if x != nil {
+		return x.NameRef
+	}
+	return nil
+}
+
 func (x *Text_TextPieceGift) GetColorId() int64 {
 	if x != nil {
 		return x.ColorId
@@ -11019,6 +11033,164 @@ func (x *Goal_GoalStats) GetTotalContributor() int64 {
 	return 0
 }
 
+type Goal_SubGoal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     int32             `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"` // Enum
+	Id       int64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Progress int64             `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Target   int64             `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`
+	Gift     *Goal_SubGoalGift `protobuf:"bytes,5,opt,name=gift,proto3" json:"gift,omitempty"`
+	IdStr    string            `protobuf:"bytes,6,opt,name=idStr,proto3" json:"idStr,omitempty"`
+}
+
+func (x *Goal_SubGoal) Reset() {
+	*x = Goal_SubGoal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Goal_SubGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal_SubGoal) ProtoMessage() {}
+
+func (x *Goal_SubGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal_SubGoal.ProtoReflect.Descriptor instead.
+func (*Goal_SubGoal) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *Goal_SubGoal) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Goal_SubGoal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Goal_SubGoal) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Goal_SubGoal) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Goal_SubGoal) GetGift() *Goal_SubGoalGift {
+	if x != nil {
+		return x.Gift
+	}
+	return nil
+}
+
+func (x *Goal_SubGoal) GetIdStr() string {
+	if x != nil {
+		return x.IdStr
+	}
+	return ""
+}
+
+type Goal_SubGoalGift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Icon         *Image `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	DiamondCount int64  `protobuf:"varint,3,opt,name=diamondCount,proto3" json:"diamondCount,omitempty"`
+	Type         int32  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Goal_SubGoalGift) Reset() {
+	*x = Goal_SubGoalGift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Goal_SubGoalGift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal_SubGoalGift) ProtoMessage() {}
+
+func (x *Goal_SubGoalGift) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal_SubGoalGift.ProtoReflect.Descriptor instead.
+func (*Goal_SubGoalGift) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *Goal_SubGoalGift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal_SubGoalGift) GetIcon() *Image {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *Goal_SubGoalGift) GetDiamondCount() int64 {
+	if x != nil {
+		return x.DiamondCount
+	}
+	return 0
+}
+
+func (x *Goal_SubGoalGift) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
 type Goal_GoalContributor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -11039,7 +11211,7 @@ type Goal_GoalContributor struct {
 func (x *Goal_GoalContributor) Reset() {
 	*x = Goal_GoalContributor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[128]
+		mi := &file_data_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11052,7 +11224,7 @@ func (x *Goal_GoalContributor) String() string {
 func (*Goal_GoalContributor) ProtoMessage() {}
 
 func (x *Goal_GoalContributor) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[128]
+	mi := &file_data_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11065,7 +11237,7 @@ func (x *Goal_GoalContributor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Goal_GoalContributor.ProtoReflect.Descriptor instead.
 func (*Goal_GoalContributor) Descriptor() ([]byte, []int) {
-	return file_data_proto_rawDescGZIP(), []int{10, 1}
+	return file_data_proto_rawDescGZIP(), []int{10, 3}
 }
 
 func (x *Goal_GoalContributor) GetUserId() int64 {
@@ -11150,7 +11322,7 @@ type LinkMicArmiesItems_LinkMicArmiesGroup struct {
 func (x *LinkMicArmiesItems_LinkMicArmiesGroup) Reset() {
 	*x = LinkMicArmiesItems_LinkMicArmiesGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[129]
+		mi := &file_data_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11163,7 +11335,7 @@ func (x *LinkMicArmiesItems_LinkMicArmiesGroup) String() string {
 func (*LinkMicArmiesItems_LinkMicArmiesGroup) ProtoMessage() {}
 
 func (x *LinkMicArmiesItems_LinkMicArmiesGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[129]
+	mi := &file_data_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11212,7 +11384,7 @@ type LinkerReplyContent_LinkmicInfo struct {
 func (x *LinkerReplyContent_LinkmicInfo) Reset() {
 	*x = LinkerReplyContent_LinkmicInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[130]
+		mi := &file_data_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11225,7 +11397,7 @@ func (x *LinkerReplyContent_LinkmicInfo) String() string {
 func (*LinkerReplyContent_LinkmicInfo) ProtoMessage() {}
 
 func (x *LinkerReplyContent_LinkmicInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[130]
+	mi := &file_data_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11318,7 +11490,7 @@ type RTCExtraInfo_RTCEngineConfig struct {
 func (x *RTCExtraInfo_RTCEngineConfig) Reset() {
 	*x = RTCExtraInfo_RTCEngineConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[131]
+		mi := &file_data_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11331,7 +11503,7 @@ func (x *RTCExtraInfo_RTCEngineConfig) String() string {
 func (*RTCExtraInfo_RTCEngineConfig) ProtoMessage() {}
 
 func (x *RTCExtraInfo_RTCEngineConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[131]
+	mi := &file_data_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11387,7 +11559,7 @@ type RTCExtraInfo_RTCLiveVideoParam struct {
 func (x *RTCExtraInfo_RTCLiveVideoParam) Reset() {
 	*x = RTCExtraInfo_RTCLiveVideoParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[132]
+		mi := &file_data_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11400,7 +11572,7 @@ func (x *RTCExtraInfo_RTCLiveVideoParam) String() string {
 func (*RTCExtraInfo_RTCLiveVideoParam) ProtoMessage() {}
 
 func (x *RTCExtraInfo_RTCLiveVideoParam) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[132]
+	mi := &file_data_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11444,7 +11616,7 @@ type RTCExtraInfo_RTCVideoParam struct {
 func (x *RTCExtraInfo_RTCVideoParam) Reset() {
 	*x = RTCExtraInfo_RTCVideoParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[133]
+		mi := &file_data_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11457,7 +11629,7 @@ func (x *RTCExtraInfo_RTCVideoParam) String() string {
 func (*RTCExtraInfo_RTCVideoParam) ProtoMessage() {}
 
 func (x *RTCExtraInfo_RTCVideoParam) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[133]
+	mi := &file_data_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11515,7 +11687,7 @@ type RTCExtraInfo_RTCBitrateMap struct {
 func (x *RTCExtraInfo_RTCBitrateMap) Reset() {
 	*x = RTCExtraInfo_RTCBitrateMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[134]
+		mi := &file_data_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11528,7 +11700,7 @@ func (x *RTCExtraInfo_RTCBitrateMap) String() string {
 func (*RTCExtraInfo_RTCBitrateMap) ProtoMessage() {}
 
 func (x *RTCExtraInfo_RTCBitrateMap) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[134]
+	mi := &file_data_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11587,7 +11759,7 @@ type MultiLiveContent_InviteBizContent struct {
 func (x *MultiLiveContent_InviteBizContent) Reset() {
 	*x = MultiLiveContent_InviteBizContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[135]
+		mi := &file_data_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11600,7 +11772,7 @@ func (x *MultiLiveContent_InviteBizContent) String() string {
 func (*MultiLiveContent_InviteBizContent) ProtoMessage() {}
 
 func (x *MultiLiveContent_InviteBizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[135]
+	mi := &file_data_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11664,7 +11836,7 @@ type MultiLiveContent_ReplyBizContent struct {
 func (x *MultiLiveContent_ReplyBizContent) Reset() {
 	*x = MultiLiveContent_ReplyBizContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[136]
+		mi := &file_data_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11677,7 +11849,7 @@ func (x *MultiLiveContent_ReplyBizContent) String() string {
 func (*MultiLiveContent_ReplyBizContent) ProtoMessage() {}
 
 func (x *MultiLiveContent_ReplyBizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[136]
+	mi := &file_data_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11728,7 +11900,7 @@ type MultiLiveContent_PermitBizContent struct {
 func (x *MultiLiveContent_PermitBizContent) Reset() {
 	*x = MultiLiveContent_PermitBizContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[137]
+		mi := &file_data_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11741,7 +11913,7 @@ func (x *MultiLiveContent_PermitBizContent) String() string {
 func (*MultiLiveContent_PermitBizContent) ProtoMessage() {}
 
 func (x *MultiLiveContent_PermitBizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[137]
+	mi := &file_data_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11798,7 +11970,7 @@ type MultiLiveContent_KickOutBizContent struct {
 func (x *MultiLiveContent_KickOutBizContent) Reset() {
 	*x = MultiLiveContent_KickOutBizContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[138]
+		mi := &file_data_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11811,7 +11983,7 @@ func (x *MultiLiveContent_KickOutBizContent) String() string {
 func (*MultiLiveContent_KickOutBizContent) ProtoMessage() {}
 
 func (x *MultiLiveContent_KickOutBizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[138]
+	mi := &file_data_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11859,7 +12031,7 @@ type BusinessContent_CohostContent struct {
 func (x *BusinessContent_CohostContent) Reset() {
 	*x = BusinessContent_CohostContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[139]
+		mi := &file_data_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11872,7 +12044,7 @@ func (x *BusinessContent_CohostContent) String() string {
 func (*BusinessContent_CohostContent) ProtoMessage() {}
 
 func (x *BusinessContent_CohostContent) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[139]
+	mi := &file_data_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11910,7 +12082,7 @@ type BusinessContent_JoinGroupBizContent struct {
 func (x *BusinessContent_JoinGroupBizContent) Reset() {
 	*x = BusinessContent_JoinGroupBizContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[140]
+		mi := &file_data_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11923,7 +12095,7 @@ func (x *BusinessContent_JoinGroupBizContent) String() string {
 func (*BusinessContent_JoinGroupBizContent) ProtoMessage() {}
 
 func (x *BusinessContent_JoinGroupBizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[140]
+	mi := &file_data_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11987,7 +12159,7 @@ type BusinessContent_Tag struct {
 func (x *BusinessContent_Tag) Reset() {
 	*x = BusinessContent_Tag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[141]
+		mi := &file_data_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12000,7 +12172,7 @@ func (x *BusinessContent_Tag) String() string {
 func (*BusinessContent_Tag) ProtoMessage() {}
 
 func (x *BusinessContent_Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[141]
+	mi := &file_data_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12060,7 +12232,7 @@ type BusinessContent_PerceptionDialogInfo struct {
 func (x *BusinessContent_PerceptionDialogInfo) Reset() {
 	*x = BusinessContent_PerceptionDialogInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[142]
+		mi := &file_data_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12073,7 +12245,7 @@ func (x *BusinessContent_PerceptionDialogInfo) String() string {
 func (*BusinessContent_PerceptionDialogInfo) ProtoMessage() {}
 
 func (x *BusinessContent_PerceptionDialogInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[142]
+	mi := &file_data_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12192,7 +12364,7 @@ type BusinessContent_PerceptionFeedbackOption struct {
 func (x *BusinessContent_PerceptionFeedbackOption) Reset() {
 	*x = BusinessContent_PerceptionFeedbackOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[143]
+		mi := &file_data_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12205,7 +12377,7 @@ func (x *BusinessContent_PerceptionFeedbackOption) String() string {
 func (*BusinessContent_PerceptionFeedbackOption) ProtoMessage() {}
 
 func (x *BusinessContent_PerceptionFeedbackOption) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[143]
+	mi := &file_data_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12248,7 +12420,7 @@ type BusinessContent_JoinGroupMessageExtra struct {
 func (x *BusinessContent_JoinGroupMessageExtra) Reset() {
 	*x = BusinessContent_JoinGroupMessageExtra{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[144]
+		mi := &file_data_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12261,7 +12433,7 @@ func (x *BusinessContent_JoinGroupMessageExtra) String() string {
 func (*BusinessContent_JoinGroupMessageExtra) ProtoMessage() {}
 
 func (x *BusinessContent_JoinGroupMessageExtra) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[144]
+	mi := &file_data_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12312,7 +12484,7 @@ type BusinessContent_Hashtag struct {
 func (x *BusinessContent_Hashtag) Reset() {
 	*x = BusinessContent_Hashtag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[145]
+		mi := &file_data_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12325,7 +12497,7 @@ func (x *BusinessContent_Hashtag) String() string {
 func (*BusinessContent_Hashtag) ProtoMessage() {}
 
 func (x *BusinessContent_Hashtag) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[145]
+	mi := &file_data_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12381,7 +12553,7 @@ type BusinessContent_TopHostInfo struct {
 func (x *BusinessContent_TopHostInfo) Reset() {
 	*x = BusinessContent_TopHostInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[146]
+		mi := &file_data_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12394,7 +12566,7 @@ func (x *BusinessContent_TopHostInfo) String() string {
 func (*BusinessContent_TopHostInfo) ProtoMessage() {}
 
 func (x *BusinessContent_TopHostInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[146]
+	mi := &file_data_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12447,7 +12619,7 @@ type BusinessContent_JoinGroupMessageExtra_RivalExtra struct {
 func (x *BusinessContent_JoinGroupMessageExtra_RivalExtra) Reset() {
 	*x = BusinessContent_JoinGroupMessageExtra_RivalExtra{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[147]
+		mi := &file_data_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12460,7 +12632,7 @@ func (x *BusinessContent_JoinGroupMessageExtra_RivalExtra) String() string {
 func (*BusinessContent_JoinGroupMessageExtra_RivalExtra) ProtoMessage() {}
 
 func (x *BusinessContent_JoinGroupMessageExtra_RivalExtra) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[147]
+	mi := &file_data_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12559,7 +12731,7 @@ type BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo struct
 func (x *BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo) Reset() {
 	*x = BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[148]
+		mi := &file_data_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12572,7 +12744,7 @@ func (x *BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo) St
 func (*BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo) ProtoMessage() {}
 
 func (x *BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[148]
+	mi := &file_data_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12694,7 +12866,7 @@ var file_data_proto_rawDesc = []byte{
 	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x4d, 0x5f,
 	0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
 	0x59, 0x5f, 0x42, 0x59, 0x50, 0x41, 0x53, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43,
-	0x48, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x22, 0xb2, 0x07, 0x0a, 0x04, 0x54, 0x65,
+	0x48, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x22, 0xe7, 0x07, 0x0a, 0x04, 0x54, 0x65,
 	0x78, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50,
 	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65,
@@ -12740,1035 +12912,1059 @@ var file_data_proto_rawDesc = []byte{
 	0x4c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73,
 	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x72,
-	0x1a, 0x41, 0x0a, 0x0d, 0x54, 0x65, 0x78, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x47, 0x69, 0x66,
+	0x1a, 0x76, 0x0a, 0x0d, 0x54, 0x65, 0x78, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x47, 0x69, 0x66,
 	0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x49, 0x64, 0x1a, 0x4f, 0x0a, 0x13, 0x54, 0x65, 0x78, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x1a, 0x48, 0x0a, 0x0d, 0x54, 0x65, 0x78, 0x74, 0x50, 0x69, 0x65, 0x63,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6c, 0x6f, 0x6e, 0x22, 0x59,
-	0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x72, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x74, 0x72, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x78, 0x74, 0x72, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x41,
-	0x6e, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x22, 0xbc, 0x11, 0x0a, 0x0b, 0x42, 0x61,
-	0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
-	0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x42, 0x61, 0x64,
-	0x67, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x42, 0x61, 0x64, 0x67,
-	0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x64,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x42, 0x61, 0x64, 0x67,
-	0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x42, 0x61, 0x64, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x73, 0x74, 0x72,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x64, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69,
-	0x6e, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61,
-	0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x1a, 0x99,
-	0x05, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12,
-	0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x42, 0x61, 0x64, 0x67,
-	0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x42, 0x61, 0x64, 0x67,
-	0x65, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x6e,
-	0x65, 0x6c, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50,
-	0x61, 0x6e, 0x65, 0x6c, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x05, 0x52, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x52, 0x65, 0x66, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x49, 0x64, 0x1a, 0x4f, 0x0a, 0x13, 0x54, 0x65, 0x78, 0x74,
+	0x50, 0x69, 0x65, 0x63, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0x48, 0x0a, 0x0d, 0x54, 0x65, 0x78,
+	0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6c,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f,
+	0x6c, 0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x74, 0x72, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x74, 0x72, 0x61, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x73, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x22, 0xbc,
+	0x11, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x3f,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2f, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x2c, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c,
+	0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x35, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x65, 0x1a, 0x99, 0x05, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x49,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x6e,
+	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x53,
+	0x0a, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x72, 0x6b,
+	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x12, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x72, 0x6b, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x63, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x4d,
+	0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69,
+	0x63, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x16, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x74,
+	0x6f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x16, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x74, 0x6f, 0x4d,
+	0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x34, 0x0a,
+	0x15, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f,
+	0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74,
+	0x79, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x1f, 0x72, 0x61, 0x6e, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x68, 0x6f,
+	0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1f, 0x72, 0x61,
+	0x6e, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x30, 0x0a,
+	0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x47, 0x75, 0x65, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x53,
+	0x74, 0x79, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x47, 0x75, 0x65, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x1a,
+	0xa4, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x69,
+	0x63, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x54, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x1a, 0x6b, 0x0a, 0x0c,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65,
 	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61,
 	0x64, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x0a, 0x62,
-	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x12, 0x62, 0x61, 0x63,
-	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65,
-	0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a,
-	0x0a, 0x10, 0x69, 0x63, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
-	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x63, 0x6f, 0x6e, 0x41, 0x75,
-	0x74, 0x6f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x62, 0x61,
-	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x69, 0x72, 0x72,
-	0x6f, 0x72, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x62, 0x61, 0x63, 0x6b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
-	0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x15, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x53,
-	0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c,
-	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x43, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x48,
-	0x0a, 0x1f, 0x72, 0x61, 0x6e, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
-	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c,
-	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1f, 0x72, 0x61, 0x6e, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x53,
-	0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x47, 0x75, 0x65, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x47, 0x75, 0x65, 0x73,
-	0x74, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x1a, 0xa4, 0x01, 0x0a, 0x0e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a,
-	0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x49, 0x63,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x1a, 0x54, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73,
-	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x69,
-	0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x1a, 0x6b, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x43, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x42, 0x61,
-	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x1a, 0xda, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x16, 0x75, 0x73, 0x65,
-	0x4e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x53, 0x74,
-	0x79, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x75, 0x73, 0x65, 0x4e, 0x65,
-	0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c,
-	0x65, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x42, 0x61,
-	0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0e,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x1a, 0x92, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x64,
-	0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x61,
+	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0xda, 0x01, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x12, 0x36,
+	0x0a, 0x16, 0x75, 0x73, 0x65, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x75, 0x73, 0x65, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x43, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x92, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x30, 0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x61,
 	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f,
-	0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x2a, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42,
-	0x61, 0x64, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x1a, 0x33, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0x6d, 0x0a, 0x0a, 0x49, 0x63, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f,
-	0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65,
-	0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x1f, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x61, 0x64, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x72, 0x22, 0x47, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x43,
-	0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x14,
-	0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x15, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x54,
-	0x52, 0x10, 0x16, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x10, 0x17,
-	0x22, 0xa2, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x41, 0x44, 0x47, 0x45, 0x44, 0x49,
-	0x53, 0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x44, 0x49, 0x53, 0x50,
-	0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12,
-	0x19, 0x0a, 0x15, 0x42, 0x41, 0x44, 0x47, 0x45, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x41,
-	0x44, 0x47, 0x45, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x41, 0x44, 0x47, 0x45,
-	0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x42,
-	0x49, 0x4e, 0x45, 0x10, 0x04, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
-	0x4f, 0x4e, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x53, 0x49,
-	0x54, 0x49, 0x4f, 0x4e, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x62,
-	0x61, 0x64, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x96, 0x0d, 0x0a, 0x0a, 0x47, 0x69, 0x66,
-	0x74, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f,
-	0x6d, 0x62, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x61, 0x6d, 0x6f,
-	0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64,
-	0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x69,
-	0x73, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4f, 0x6e, 0x50, 0x61, 0x6e, 0x65,
-	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x44, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x64, 0x4f, 0x6e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x70,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0d, 0x67, 0x69, 0x66, 0x74, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x67, 0x69, 0x66, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49,
-	0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69,
-	0x63, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x6c, 0x64, 0x45, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x6f, 0x6c, 0x64, 0x45, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x45, 0x0a, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c,
-	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x69, 0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74,
-	0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x42, 0x65, 0x66, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x45, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x42, 0x65, 0x66, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73,
-	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x69, 0x73, 0x42, 0x6f, 0x78, 0x47, 0x69, 0x66, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6f, 0x78, 0x47, 0x69, 0x66, 0x74, 0x12, 0x28, 0x0a, 0x0f,
-	0x63, 0x61, 0x6e, 0x50, 0x75, 0x74, 0x49, 0x6e, 0x47, 0x69, 0x66, 0x74, 0x42, 0x6f, 0x78, 0x18,
-	0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x50, 0x75, 0x74, 0x49, 0x6e, 0x47,
-	0x69, 0x66, 0x74, 0x42, 0x6f, 0x78, 0x1a, 0xd0, 0x01, 0x0a, 0x0f, 0x47, 0x69, 0x66, 0x74, 0x50,
-	0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54,
-	0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c,
-	0x65, 0x66, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x11, 0x62, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x4c, 0x79, 0x6e,
-	0x78, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x4c, 0x79, 0x6e, 0x78, 0x55, 0x72, 0x6c, 0x1a, 0x9b, 0x02, 0x0a, 0x14, 0x47, 0x69,
-	0x66, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x57, 0x0a, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74,
-	0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74,
-	0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x48, 0x0a,
-	0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x42,
-	0x75, 0x62, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66,
-	0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x1a, 0x6a, 0x0a, 0x10, 0x52, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x47, 0x69, 0x66, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x34, 0x0a,
-	0x11, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x45, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x11, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x45, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x1a, 0xcb, 0x02, 0x0a, 0x15, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69,
-	0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a,
-	0x07, 0x62, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x07, 0x62, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x2a, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x73,
-	0x68, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x6c,
-	0x65, 0x66, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a,
-	0x11, 0x62, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x62, 0x67, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x22, 0xb2, 0x54, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x69, 0x6f, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x62, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
-	0x0a, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x61, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x12, 0x2a, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4d, 0x65,
-	0x64, 0x69, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4c, 0x61,
-	0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x51, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x69, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x51, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x55,
-	0x72, 0x69, 0x12, 0x2e, 0x0a, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61,
-	0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x08, 0x70, 0x61, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65,
-	0x12, 0x2a, 0x0a, 0x08, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c,
-	0x75, 0x62, 0x52, 0x08, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x12, 0x24, 0x0a, 0x06,
-	0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x62, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x2a, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c,
-	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x05,
-	0x6d, 0x65, 0x64, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x11, 0x72, 0x65,
-	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x72,
-	0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x3a, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49,
-	0x63, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x14, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x6c, 0x54,
-	0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x6f, 0x70, 0x56, 0x69, 0x70, 0x4e, 0x6f, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x74, 0x6f, 0x70, 0x56, 0x69, 0x70, 0x4e, 0x6f, 0x12, 0x2a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x41, 0x74, 0x74, 0x72, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x41, 0x74, 0x74, 0x72, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x18,
-	0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x77, 0x6e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0c, 0x6c,
-	0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0e, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18, 0x26, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a,
-	0x16, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x77,
-	0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x69, 0x74, 0x68, 0x46, 0x75, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x28, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x46, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68,
-	0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x12, 0x77, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x2a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x12, 0x77, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x41,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x2b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0b, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0b, 0x74, 0x6f, 0x70,
-	0x46, 0x61, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x46, 0x61, 0x6e, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x55, 0x69, 0x64, 0x18, 0x2e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x55, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61,
-	0x72, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x12, 0x48,
-	0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x13, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x39, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x13, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x42, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x57, 0x65,
-	0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72,
-	0x63, 0x65, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
-	0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61, 0x64,
-	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a,
-	0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x40, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x09,
-	0x62, 0x61, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x41,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x61, 0x6e, 0x73, 0x43,
-	0x6c, 0x75, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0c, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x31, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x73, 0x12, 0x3b, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65, 0x72,
-	0x73, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x18, 0xeb,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65,
-	0x72, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
-	0x51, 0x0a, 0x23, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x23, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x12, 0x35, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x53, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0xed, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68,
-	0x6f, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x18, 0xee,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77,
-	0x49, 0x6e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x12, 0x2d, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xef, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x4d,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x53, 0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xf0,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x16, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0xf1, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x18, 0xf2, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x12, 0x34,
-	0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0xf3, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4a, 0x70,
-	0x67, 0x18, 0xf4, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4a, 0x70, 0x67, 0x12, 0x1b, 0x0a, 0x08, 0x62,
-	0x67, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x18, 0xf5, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x62, 0x67, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xf8, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0xf9,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65,
-	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xfa, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
-	0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x63, 0x68, 0x61, 0x74, 0x18, 0xfb, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x63, 0x68,
-	0x61, 0x74, 0x12, 0x27, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x63, 0x68, 0x61,
-	0x74, 0x49, 0x6d, 0x67, 0x18, 0xfc, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x49, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x67, 0x12, 0x11, 0x0a, 0x03, 0x65,
-	0x78, 0x70, 0x18, 0xfd, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x78, 0x70, 0x12, 0x27,
-	0x0a, 0x0e, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0xfe, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x10, 0x66, 0x6f, 0x6c, 0x64, 0x53,
-	0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x18, 0xff, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x10, 0x66, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x72,
-	0x43, 0x68, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x80, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x11, 0x69, 0x63, 0x68,
-	0x61, 0x74, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x83,
-	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x69, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x05, 0x69, 0x64, 0x53, 0x74,
-	0x72, 0x18, 0x84, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x12,
-	0x1f, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x85, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x12, 0x21, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18,
-	0x86, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x10, 0x6e, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x18, 0x87, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
-	0x6e, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65,
-	0x12, 0x1d, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x88, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
-	0x2d, 0x0a, 0x11, 0x70, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x89, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x75, 0x73,
-	0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
-	0x0a, 0x08, 0x70, 0x75, 0x73, 0x68, 0x44, 0x69, 0x67, 0x67, 0x18, 0x8a, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x70, 0x75, 0x73, 0x68, 0x44, 0x69, 0x67, 0x67, 0x12, 0x1f, 0x0a, 0x0a, 0x70,
-	0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x8b, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x10,
-	0x70, 0x75, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x8c, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x75, 0x73, 0x68, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x75, 0x73,
-	0x68, 0x49, 0x63, 0x68, 0x61, 0x74, 0x18, 0x8d, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
-	0x75, 0x73, 0x68, 0x49, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x8e, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70,
-	0x75, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x70, 0x75, 0x73,
-	0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x8f, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x2f, 0x0a, 0x12, 0x70, 0x75, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x90, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x70,
-	0x75, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x91, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0e, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x93, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x72, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x94, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61,
-	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x11, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
-	0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x95, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x73, 0x63, 0x6d,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x96, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63,
-	0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x97, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19,
-	0x0a, 0x07, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x98, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0xce, 0x03, 0x0a, 0x0d, 0x4c, 0x69,
-	0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x68, 0x61, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x22, 0x0a, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x1a, 0x49, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x61, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x69, 0x61, 0x70, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x64, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x75, 0x73, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x5c, 0x0a, 0x0e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x19,
-	0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x43, 0x4f, 0x49, 0x4e, 0x53,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x50, 0x41, 0x59, 0x4d, 0x45,
-	0x54, 0x48, 0x4f, 0x44, 0x43, 0x41, 0x53, 0x48, 0x10, 0x02, 0x1a, 0x50, 0x0a, 0x0c, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x61,
-	0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x74, 0x61, 0x67, 0x1a, 0xe1, 0x04, 0x0a,
-	0x0b, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x3c, 0x0a, 0x19, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x3e, 0x0a, 0x1a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x30, 0x0a, 0x13, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74,
-	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x74, 0x61, 0x73, 0x6b,
-	0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x74, 0x61, 0x73, 0x6b, 0x44, 0x65,
-	0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x32, 0x0a, 0x14, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
-	0x74, 0x61, 0x73, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x45,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x12, 0x38, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x13, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x42, 0x61,
-	0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a,
-	0x73, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x6d,
-	0x61, 0x6c, 0x6c, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x49, 0x63, 0x6f, 0x6e,
-	0x1a, 0xaa, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x6e, 0x74,
-	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x1a, 0xc1, 0x02,
-	0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x28, 0x0a,
-	0x0f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a,
-	0x15, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x53, 0x68,
-	0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x14, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x6c,
-	0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x8c, 0x02, 0x0a, 0x06, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x04,
-	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x44, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x69, 0x62, 0x62, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x17, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x69, 0x62, 0x62, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42,
-	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x40,
-	0x0a, 0x1b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x1a, 0x4c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xf0,
-	0x0e, 0x0a, 0x11, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45,
-	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x54, 0x0a,
-	0x10, 0x73, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x2a, 0x0a, 0x0a, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x33, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0x6d, 0x0a, 0x0a,
+	0x49, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x1f, 0x0a, 0x0b, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x72, 0x22, 0x47, 0x0a, 0x08,
+	0x44, 0x61, 0x74, 0x61, 0x43, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d,
+	0x41, 0x47, 0x45, 0x10, 0x14, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x15, 0x12,
+	0x07, 0x0a, 0x03, 0x53, 0x54, 0x52, 0x10, 0x16, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x42,
+	0x49, 0x4e, 0x45, 0x10, 0x17, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x41,
+	0x44, 0x47, 0x45, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47,
+	0x45, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x41,
+	0x47, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x44, 0x47, 0x45, 0x44, 0x49, 0x53,
+	0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x42, 0x41, 0x44, 0x47, 0x45, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x42, 0x41, 0x44, 0x47, 0x45, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02,
+	0x42, 0x0b, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x96, 0x0d,
+	0x0a, 0x0a, 0x47, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x6d,
+	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4f,
+	0x6e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4f, 0x6e, 0x50, 0x61, 0x6e, 0x65, 0x6c,
+	0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0d, 0x67, 0x69,
+	0x66, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x67, 0x69, 0x66, 0x74, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04,
+	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x6c, 0x64,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x6f,
+	0x6c, 0x64, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65,
+	0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x47, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x0f, 0x67, 0x69, 0x66, 0x74,
+	0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x69,
+	0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x18, 0x31,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x47, 0x69, 0x66, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x42, 0x65, 0x66, 0x76, 0x69, 0x65, 0x77, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x69, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x42, 0x65, 0x66, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x18,
+	0x33, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47,
+	0x69, 0x66, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6f, 0x78, 0x47, 0x69, 0x66, 0x74,
+	0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6f, 0x78, 0x47, 0x69, 0x66,
+	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x50, 0x75, 0x74, 0x49, 0x6e, 0x47, 0x69, 0x66,
+	0x74, 0x42, 0x6f, 0x78, 0x18, 0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x50,
+	0x75, 0x74, 0x49, 0x6e, 0x47, 0x69, 0x66, 0x74, 0x42, 0x6f, 0x78, 0x1a, 0xd0, 0x01, 0x0a, 0x0f,
+	0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74,
+	0x49, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x67,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x62, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x4c, 0x79, 0x6e, 0x78, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x4c, 0x79, 0x6e, 0x78, 0x55, 0x72, 0x6c, 0x1a, 0x9b,
+	0x02, 0x0a, 0x14, 0x47, 0x69, 0x66, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x57, 0x0a, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61,
+	0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x48, 0x0a, 0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74,
+	0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x47,
+	0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x47, 0x69, 0x66, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x1a, 0x6a, 0x0a, 0x10,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x34, 0x0a, 0x11, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x1a, 0xcb, 0x02, 0x0a, 0x15, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x12, 0x20, 0x0a, 0x07, 0x62, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x07, 0x62, 0x67, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x22, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74,
+	0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55,
+	0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x62,
+	0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xb2, 0x54, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x62,
+	0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54, 0x68, 0x75,
+	0x6d, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x12, 0x2a, 0x0a,
+	0x0c, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x0b, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4c, 0x61,
+	0x72, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x51, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x72,
+	0x69, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x51, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x61, 0x79,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x08, 0x70, 0x61, 0x79,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75,
+	0x62, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46,
+	0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x08, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75,
+	0x62, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x61, 0x6c, 0x49, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x61, 0x6c, 0x12,
+	0x34, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x11, 0x72, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x1e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x14, 0x6e, 0x65, 0x77,
+	0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x56, 0x69, 0x70, 0x4e, 0x6f, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x56, 0x69, 0x70, 0x4e, 0x6f, 0x12, 0x2a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x77, 0x6e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x2e, 0x4f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x25, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49,
+	0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x72,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x27, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x16, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x69,
+	0x74, 0x68, 0x46, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x46, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x12,
+	0x77, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x12, 0x77, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x28, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x46, 0x61, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x2d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x46,
+	0x61, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x55, 0x69,
+	0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x55, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x31, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43,
+	0x61, 0x72, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a,
+	0x13, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x39, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x13, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x72, 0x63, 0x65, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x1c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x62,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x62,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x3e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x40, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x41, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0c,
+	0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x42, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c,
+	0x75, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6e,
+	0x64, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0xea, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x12, 0x51, 0x0a, 0x23, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68,
+	0x65, 0x72, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x53,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x18, 0xec, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x23, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x35, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0xed, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d,
+	0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x18, 0xee, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x12, 0x2d, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0xef, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x53, 0x68, 0x6f, 0x77, 0x4d, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0xf0, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x53, 0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xf1, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x18, 0xf2, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x6d, 0x69, 0x63, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0xf3, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0b, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x4a, 0x70, 0x67, 0x18, 0xf4, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4a, 0x70, 0x67,
+	0x12, 0x1b, 0x0a, 0x08, 0x62, 0x67, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x18, 0xf5, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x67, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xf8, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x29, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x18, 0xf9, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xfa, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x63, 0x68,
+	0x61, 0x74, 0x18, 0xfb, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x63, 0x68, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x67, 0x18, 0xfc, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x67,
+	0x12, 0x11, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0xfd, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x65, 0x78, 0x70, 0x12, 0x27, 0x0a, 0x0e, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0xfe, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x61,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x10,
+	0x66, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74,
+	0x18, 0xff, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x80, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d,
+	0x0a, 0x11, 0x69, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x83, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x69, 0x63, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a,
+	0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x18, 0x84, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x64, 0x53, 0x74, 0x72, 0x12, 0x1f, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x18, 0x85, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x18, 0x86, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x10, 0x6e, 0x65, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x75, 0x69, 0x64, 0x65, 0x18, 0x87, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x47, 0x75, 0x69, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x88, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x11, 0x70, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x89, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x70, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x75, 0x73, 0x68, 0x44, 0x69, 0x67, 0x67, 0x18,
+	0x8a, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x75, 0x73, 0x68, 0x44, 0x69, 0x67, 0x67,
+	0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x8b,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x12, 0x2b, 0x0a, 0x10, 0x70, 0x75, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x8c, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x75,
+	0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x09, 0x70, 0x75, 0x73, 0x68, 0x49, 0x63, 0x68, 0x61, 0x74, 0x18, 0x8d, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x73, 0x68, 0x49, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x0a,
+	0x0a, 0x70, 0x75, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x8e, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x18,
+	0x8f, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x12, 0x70, 0x75, 0x73, 0x68, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x90, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x70, 0x75, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x91, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x93, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x43,
+	0x61, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x94, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x77,
+	0x69, 0x74, 0x68, 0x43, 0x61, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x11, 0x75, 0x70,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x95, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x75, 0x70, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x08, 0x73, 0x63, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x96, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x63, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x46, 0x0a, 0x11, 0x65,
 	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
-	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x60, 0x0a, 0x14, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72,
-	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x63,
-	0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x14, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xa0, 0x0a, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68,
-	0x6f, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x70,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x53,
-	0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x6f, 0x6c,
-	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x65,
-	0x78, 0x70, 0x52, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x65, 0x78, 0x70, 0x52, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70,
-	0x52, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x52, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70,
-	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x44,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x72, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74,
-	0x79, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x68, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x4e,
-	0x6f, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x73, 0x68, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0xdc, 0x06, 0x0a, 0x0a, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x6c, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x69,
-	0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x46, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61,
-	0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x42, 0x79, 0x74, 0x65,
-	0x6d, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x42, 0x79,
-	0x74, 0x65, 0x6d, 0x61, 0x6c, 0x6c, 0x1a, 0xb5, 0x04, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x7f, 0x0a,
-	0x0f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x53,
-	0x68, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x7d,
-	0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x72, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x53,
-	0x68, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x72, 0x1a,
-	0xdc, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x68, 0x75, 0x6d,
-	0x62, 0x55, 0x72, 0x69, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18,
-	0x0a, 0x07, 0x75, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x75, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x87,
-	0x01, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0e,
-	0x0a, 0x0a, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21,
-	0x0a, 0x1d, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x41,
-	0x42, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c, 0x55, 0x45, 0x5f, 0x56, 0x10,
-	0x03, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x44,
-	0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x5f,
-	0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x04, 0x1a, 0x5e, 0x0a, 0x14, 0x53, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x28, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x53, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f,
-	0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
-	0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46,
-	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x49,
-	0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x02,
-	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x03, 0x22, 0x33, 0x0a, 0x0c,
-	0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x4f,
-	0x57, 0x43, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x48, 0x4f, 0x50, 0x10,
-	0x02, 0x1a, 0xd7, 0x03, 0x0a, 0x08, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x12, 0x2f,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x2e, 0x46, 0x61, 0x6e,
-	0x73, 0x43, 0x6c, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0xe2, 0x02, 0x0a, 0x0c, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x12, 0x5e, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c,
-	0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x2e, 0x46,
-	0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12,
-	0x75, 0x73, 0x65, 0x72, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x47,
-	0x69, 0x66, 0x74, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x69, 0x66, 0x74, 0x49,
-	0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x49, 0x64, 0x22, 0x31, 0x0a, 0x09, 0x42, 0x61, 0x64, 0x67, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x49, 0x43, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x49,
-	0x43, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x3d, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x6e,
-	0x73, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0x02, 0x22, 0x35, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x45, 0x53, 0x4f,
-	0x4e, 0x41, 0x4c, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
-	0x4f, 0x54, 0x48, 0x45, 0x52, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x01, 0x1a, 0xa6, 0x01, 0x0a, 0x0c,
-	0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
-	0x69, 0x73, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x69, 0x73, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09,
-	0x66, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x66, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61,
-	0x6e, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66,
-	0x61, 0x6e, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6e, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x6e, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9e, 0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x53, 0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x53, 0x74, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6f, 0x6d,
-	0x49, 0x64, 0x73, 0x53, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xf4, 0x07, 0x0a, 0x08, 0x50,
-	0x61, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x6d, 0x6f,
-	0x6e, 0x64, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x49, 0x63, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6e,
-	0x65, 0x78, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x3e, 0x0a,
-	0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0e,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x47,
-	0x72, 0x61, 0x64, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x0d,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x68, 0x61,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x69, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69,
-	0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0f, 0x69, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x69, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x57, 0x69,
-	0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x49,
-	0x63, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x12, 0x6e,
-	0x65, 0x77, 0x49, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x12, 0x6e, 0x65, 0x77, 0x49, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x76, 0x65, 0x49, 0x63,
-	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x0b, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
-	0x12, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x75, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x4e, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x69, 0x76, 0x69,
-	0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2e, 0x0a,
-	0x0e, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x62,
-	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x42, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x12, 0x39, 0x0a, 0x13, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x42, 0x61, 0x63, 0x6b,
-	0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x18, 0x97, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x11, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x07, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x98,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0xce,
+	0x03, 0x0a, 0x0d, 0x4c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x68, 0x61, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x49, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x61, 0x70, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x61, 0x70, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x75, 0x73, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x68, 0x6f,
+	0x77, 0x22, 0x5c, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x43, 0x4f, 0x49, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x43, 0x41, 0x53, 0x48, 0x10, 0x02, 0x1a,
+	0x50, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x22, 0x0a,
+	0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x74, 0x61,
+	0x67, 0x1a, 0xe1, 0x04, 0x0a, 0x0b, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x19, 0x6c, 0x6f, 0x77, 0x65, 0x73,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6c, 0x6f, 0x77, 0x65,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x1a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x68, 0x69, 0x67, 0x68, 0x65,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x69, 0x73,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x16, 0x74, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x74,
+	0x61, 0x73, 0x6b, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x73,
+	0x6b, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x74, 0x61, 0x73, 0x6b, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x12, 0x38, 0x0a,
 	0x13, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67,
-	0x42, 0x61, 0x63, 0x6b, 0x1a, 0x7b, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x63, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74,
-	0x72, 0x1a, 0x5e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61,
-	0x64, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x6d, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x6d, 0x67, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x49, 0x6d, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d,
-	0x67, 0x1a, 0x93, 0x03, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6c,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x62,
-	0x61, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65,
-	0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x49, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x49, 0x6e,
-	0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x69,
-	0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12,
-	0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x41, 0x75,
-	0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x47, 0x69,
-	0x66, 0x74, 0x53, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x47, 0x69, 0x66, 0x74,
-	0x53, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x86, 0x01, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x74, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x53, 0x75,
-	0x70, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
-	0x6d, 0x75, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x6d, 0x75, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0xc3, 0x03, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x64, 0x53, 0x74, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x61,
+	0x42, 0x61, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f,
+	0x67, 0x42, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x24, 0x0a, 0x09, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x73, 0x6d, 0x61, 0x6c,
+	0x6c, 0x49, 0x63, 0x6f, 0x6e, 0x1a, 0xaa, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x12, 0x36, 0x0a, 0x16, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x13, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x1a, 0xc1, 0x02, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x14, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x61, 0x72, 0x69,
+	0x65, 0x74, 0x79, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x53,
+	0x68, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x8c, 0x02, 0x0a, 0x06, 0x42, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x17, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x69, 0x62, 0x62, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x69, 0x62, 0x62, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x15, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x1b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0x4c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69,
+	0x63, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0xf0, 0x0e, 0x0a, 0x11, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x65, 0x6e, 0x74,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e,
+	0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x54, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x60, 0x0a, 0x14, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x14, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xa0, 0x0a, 0x0a, 0x10, 0x53,
+	0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x53, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0a, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x52, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x65, 0x78, 0x70,
+	0x52, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x65, 0x78, 0x70, 0x52, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x52, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x68, 0x6f, 0x77,
+	0x52, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x73, 0x68, 0x6f, 0x77, 0x52, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0xdc,
+	0x06, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x6c, 0x0a,
+	0x0d, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68,
+	0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0d, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x42, 0x79, 0x74, 0x65, 0x6d, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x42, 0x79, 0x74, 0x65, 0x6d, 0x61, 0x6c, 0x6c, 0x1a, 0xb5, 0x04, 0x0a, 0x12,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x7f, 0x0a, 0x0f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x0f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x7d, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x2e, 0x45, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61,
+	0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x53, 0x74, 0x72, 0x1a, 0xdc, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x6f, 0x70, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x12, 0x22, 0x0a,
+	0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x55, 0x72, 0x69, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x49, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x42, 0x52, 0x41,
+	0x4e, 0x44, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c,
+	0x55, 0x45, 0x5f, 0x56, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f,
+	0x42, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x04, 0x1a, 0x5e, 0x0a,
+	0x14, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53,
+	0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09,
+	0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f,
+	0x46, 0x46, 0x49, 0x43, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
+	0x03, 0x22, 0x33, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x48, 0x4f, 0x57, 0x43, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x48, 0x4f, 0x50, 0x10, 0x02, 0x1a, 0xd7, 0x03, 0x0a, 0x08, 0x46, 0x61, 0x6e, 0x73, 0x43,
+	0x6c, 0x75, 0x62, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75,
+	0x62, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0xe2, 0x02, 0x0a, 0x0c, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75,
+	0x62, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x5e, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x46,
+	0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43,
+	0x6c, 0x75, 0x62, 0x2e, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x47, 0x69, 0x66, 0x74, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x47, 0x69, 0x66, 0x74, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x09, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x49, 0x63, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x4d, 0x41, 0x4c, 0x4c, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x3d, 0x0a, 0x12, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49,
+	0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x22, 0x35, 0x0a, 0x0f, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f,
+	0x50, 0x52, 0x45, 0x53, 0x4f, 0x4e, 0x41, 0x4c, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x01,
+	0x1a, 0xa6, 0x01, 0x0a, 0x0c, 0x46, 0x61, 0x6e, 0x73, 0x43, 0x6c, 0x75, 0x62, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6e, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x6e, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a,
+	0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x66, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9e, 0x01, 0x0a, 0x0a, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75,
+	0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x70, 0x75, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x53, 0x0a, 0x07, 0x4f, 0x77,
+	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x73, 0x53, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x53, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x1a,
+	0xf4, 0x07, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0b,
+	0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x6d, 0x6f,
+	0x6e, 0x64, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74,
+	0x49, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x63, 0x6f, 0x6e, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x43, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x69, 0x6d,
+	0x49, 0x63, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0f, 0x69, 0x6d,
+	0x49, 0x63, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x69, 0x6d, 0x49,
+	0x63, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x08,
+	0x6c, 0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x6d, 0x49, 0x63, 0x6f, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x4c,
+	0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x76, 0x65, 0x49, 0x63,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x65, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x61,
+	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x42, 0x61, 0x63, 0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x18, 0xea,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x67, 0x12, 0x39,
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x42,
+	0x67, 0x42, 0x61, 0x63, 0x6b, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x61,
+	0x6c, 0x6f, 0x67, 0x42, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x1a, 0x7b, 0x0a, 0x09, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x6d, 0x6f, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x61,
+	0x6d, 0x6f, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x1a, 0x5e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x49, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x6d, 0x67, 0x12, 0x26,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x6d, 0x67, 0x1a, 0x93, 0x03, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x49, 0x6e, 0x47, 0x72,
+	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x73, 0x49, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64,
+	0x54, 0x6f, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74,
+	0x53, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75,
+	0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2c,
+	0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x41,
+	0x75, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x86, 0x01, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4d,
+	0x75, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x75,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x22, 0x0a,
+	0x0c, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x75, 0x74, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xc3, 0x03, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x13, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x61,
 	0x69, 0x6c, 0x79, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x61,
-	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11,
-	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x69,
-	0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x74, 0x75, 0x77, 0x65, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x75, 0x77, 0x65, 0x6e, 0x49, 0x74, 0x65,
-	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x05, 0x45, 0x6d, 0x6f, 0x74, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x61, 0x75, 0x64,
-	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x09,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0a, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x10,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x32, 0x0a, 0x14, 0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64,
+	0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x75, 0x77, 0x65, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x75, 0x77,
+	0x65, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x05,
+	0x45, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a,
+	0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x28, 0x0a, 0x09, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x3d, 0x0a, 0x10, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x45, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10,
 	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x0f,
-	0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0c, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x55, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73,
-	0x68, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x09, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14,
-	0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x47, 0x69, 0x76, 0x65, 0x72,
-	0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
-	0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x47, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x14, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4f,
-	0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x1b, 0x69, 0x73, 0x4d, 0x75, 0x74,
-	0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68,
-	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x69, 0x73,
-	0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x57,
-	0x69, 0x74, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x73, 0x4d,
+	0x22, 0xd5, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x09, 0x4d, 0x73, 0x67, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x64, 0x54, 0x6f, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74,
+	0x47, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x47, 0x69, 0x76, 0x65, 0x72,
+	0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x1b,
+	0x69, 0x73, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1b, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x2e,
+	0x0a, 0x12, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x30,
+	0x0a, 0x13, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x66, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x4d,
 	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x73, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0xc5, 0x06, 0x0a, 0x04, 0x47, 0x6f, 0x61, 0x6c,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x6c,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a,
-	0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64,
-	0x53, 0x74, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
-	0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x09, 0x47, 0x6f, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x69, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x1a,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0x90, 0x09, 0x0a,
+	0x04, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x47, 0x6f, 0x61, 0x6c,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f,
+	0x61, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x47,
+	0x6f, 0x61, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65,
+	0x61, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
+	0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47,
+	0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x6f, 0x72, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x2e, 0x47, 0x6f,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x57,
+	0x0a, 0x09, 0x47, 0x6f, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x1a, 0x9e, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x47,
+	0x6f, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x67,
+	0x69, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x61, 0x6c,
+	0x2e, 0x53, 0x75, 0x62, 0x47, 0x6f, 0x61, 0x6c, 0x47, 0x69, 0x66, 0x74, 0x52, 0x04, 0x67, 0x69,
+	0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x64, 0x53, 0x74, 0x72, 0x1a, 0x75, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x47,
+	0x6f, 0x61, 0x6c, 0x47, 0x69, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x61, 0x6d, 0x6f,
+	0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64,
+	0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a,
 	0xcd, 0x02, 0x0a, 0x0f, 0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
 	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x61,
@@ -14713,7 +14909,7 @@ func file_data_proto_rawDescGZIP() []byte {
 }
 
 var file_data_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_data_proto_msgTypes = make([]protoimpl.MessageInfo, 149)
+var file_data_proto_msgTypes = make([]protoimpl.MessageInfo, 151)
 var file_data_proto_goTypes = []any{
 	(Common_IMDispatchStrategy)(0),                                              // 0: Common.IMDispatchStrategy
 	(BadgeStruct_DataCase)(0),                                                   // 1: BadgeStruct.DataCase
@@ -14855,39 +15051,41 @@ var file_data_proto_goTypes = []any{
 	(*User_FansClub_FansClubData)(nil),                                                           // 137: User.FansClub.FansClubData
 	(*User_PayGrade_GradeIcon)(nil),                                                              // 138: User.PayGrade.GradeIcon
 	(*Goal_GoalStats)(nil),                                                                       // 139: Goal.GoalStats
-	(*Goal_GoalContributor)(nil),                                                                 // 140: Goal.GoalContributor
-	(*LinkMicArmiesItems_LinkMicArmiesGroup)(nil),                                                // 141: LinkMicArmiesItems.LinkMicArmiesGroup
-	(*LinkerReplyContent_LinkmicInfo)(nil),                                                       // 142: LinkerReplyContent.LinkmicInfo
-	(*RTCExtraInfo_RTCEngineConfig)(nil),                                                         // 143: RTCExtraInfo.RTCEngineConfig
-	(*RTCExtraInfo_RTCLiveVideoParam)(nil),                                                       // 144: RTCExtraInfo.RTCLiveVideoParam
-	(*RTCExtraInfo_RTCVideoParam)(nil),                                                           // 145: RTCExtraInfo.RTCVideoParam
-	(*RTCExtraInfo_RTCBitrateMap)(nil),                                                           // 146: RTCExtraInfo.RTCBitrateMap
-	(*MultiLiveContent_InviteBizContent)(nil),                                                    // 147: MultiLiveContent.InviteBizContent
-	(*MultiLiveContent_ReplyBizContent)(nil),                                                     // 148: MultiLiveContent.ReplyBizContent
-	(*MultiLiveContent_PermitBizContent)(nil),                                                    // 149: MultiLiveContent.PermitBizContent
-	(*MultiLiveContent_KickOutBizContent)(nil),                                                   // 150: MultiLiveContent.KickOutBizContent
-	(*BusinessContent_CohostContent)(nil),                                                        // 151: BusinessContent.CohostContent
-	(*BusinessContent_JoinGroupBizContent)(nil),                                                  // 152: BusinessContent.JoinGroupBizContent
-	(*BusinessContent_Tag)(nil),                                                                  // 153: BusinessContent.Tag
-	(*BusinessContent_PerceptionDialogInfo)(nil),                                                 // 154: BusinessContent.PerceptionDialogInfo
-	(*BusinessContent_PerceptionFeedbackOption)(nil),                                             // 155: BusinessContent.PerceptionFeedbackOption
-	(*BusinessContent_JoinGroupMessageExtra)(nil),                                                // 156: BusinessContent.JoinGroupMessageExtra
-	(*BusinessContent_Hashtag)(nil),                                                              // 157: BusinessContent.Hashtag
-	(*BusinessContent_TopHostInfo)(nil),                                                          // 158: BusinessContent.TopHostInfo
-	(*BusinessContent_JoinGroupMessageExtra_RivalExtra)(nil),                                     // 159: BusinessContent.JoinGroupMessageExtra.RivalExtra
-	(*BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo)(nil),                  // 160: BusinessContent.JoinGroupMessageExtra.RivalExtra.AuthenticationInfo
-	(LinkmicStatus)(0),                                                                           // 161: LinkmicStatus
-	(AuditStatus)(0),                                                                             // 162: AuditStatus
-	(EmoteType)(0),                                                                               // 163: EmoteType
-	(ContentSource)(0),                                                                           // 164: ContentSource
-	(EmotePrivateType)(0),                                                                        // 165: EmotePrivateType
-	(KickoutReason)(0),                                                                           // 166: KickoutReason
-	(LinkmicApplierSortSetting)(0),                                                               // 167: LinkmicApplierSortSetting
-	(GroupStatus)(0),                                                                             // 168: GroupStatus
-	(TextType)(0),                                                                                // 169: TextType
-	(ReplyStatus)(0),                                                                             // 170: ReplyStatus
-	(AgreeStatus)(0),                                                                             // 171: AgreeStatus
-	(HashtagNamespace)(0),                                                                        // 172: HashtagNamespace
+	(*Goal_SubGoal)(nil),                                                                         // 140: Goal.SubGoal
+	(*Goal_SubGoalGift)(nil),                                                                     // 141: Goal.SubGoalGift
+	(*Goal_GoalContributor)(nil),                                                                 // 142: Goal.GoalContributor
+	(*LinkMicArmiesItems_LinkMicArmiesGroup)(nil),                                                // 143: LinkMicArmiesItems.LinkMicArmiesGroup
+	(*LinkerReplyContent_LinkmicInfo)(nil),                                                       // 144: LinkerReplyContent.LinkmicInfo
+	(*RTCExtraInfo_RTCEngineConfig)(nil),                                                         // 145: RTCExtraInfo.RTCEngineConfig
+	(*RTCExtraInfo_RTCLiveVideoParam)(nil),                                                       // 146: RTCExtraInfo.RTCLiveVideoParam
+	(*RTCExtraInfo_RTCVideoParam)(nil),                                                           // 147: RTCExtraInfo.RTCVideoParam
+	(*RTCExtraInfo_RTCBitrateMap)(nil),                                                           // 148: RTCExtraInfo.RTCBitrateMap
+	(*MultiLiveContent_InviteBizContent)(nil),                                                    // 149: MultiLiveContent.InviteBizContent
+	(*MultiLiveContent_ReplyBizContent)(nil),                                                     // 150: MultiLiveContent.ReplyBizContent
+	(*MultiLiveContent_PermitBizContent)(nil),                                                    // 151: MultiLiveContent.PermitBizContent
+	(*MultiLiveContent_KickOutBizContent)(nil),                                                   // 152: MultiLiveContent.KickOutBizContent
+	(*BusinessContent_CohostContent)(nil),                                                        // 153: BusinessContent.CohostContent
+	(*BusinessContent_JoinGroupBizContent)(nil),                                                  // 154: BusinessContent.JoinGroupBizContent
+	(*BusinessContent_Tag)(nil),                                                                  // 155: BusinessContent.Tag
+	(*BusinessContent_PerceptionDialogInfo)(nil),                                                 // 156: BusinessContent.PerceptionDialogInfo
+	(*BusinessContent_PerceptionFeedbackOption)(nil),                                             // 157: BusinessContent.PerceptionFeedbackOption
+	(*BusinessContent_JoinGroupMessageExtra)(nil),                                                // 158: BusinessContent.JoinGroupMessageExtra
+	(*BusinessContent_Hashtag)(nil),                                                              // 159: BusinessContent.Hashtag
+	(*BusinessContent_TopHostInfo)(nil),                                                          // 160: BusinessContent.TopHostInfo
+	(*BusinessContent_JoinGroupMessageExtra_RivalExtra)(nil),                                     // 161: BusinessContent.JoinGroupMessageExtra.RivalExtra
+	(*BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo)(nil),                  // 162: BusinessContent.JoinGroupMessageExtra.RivalExtra.AuthenticationInfo
+	(LinkmicStatus)(0),                                                                           // 163: LinkmicStatus
+	(AuditStatus)(0),                                                                             // 164: AuditStatus
+	(EmoteType)(0),                                                                               // 165: EmoteType
+	(ContentSource)(0),                                                                           // 166: ContentSource
+	(EmotePrivateType)(0),                                                                        // 167: EmotePrivateType
+	(KickoutReason)(0),                                                                           // 168: KickoutReason
+	(LinkmicApplierSortSetting)(0),                                                               // 169: LinkmicApplierSortSetting
+	(GroupStatus)(0),                                                                             // 170: GroupStatus
+	(TextType)(0),                                                                                // 171: TextType
+	(ReplyStatus)(0),                                                                             // 172: ReplyStatus
+	(AgreeStatus)(0),                                                                             // 173: AgreeStatus
+	(HashtagNamespace)(0),                                                                        // 174: HashtagNamespace
 }
 var file_data_proto_depIdxs = []int32{
 	13,  // 0: Common.displayText:type_name -> Text
@@ -14921,7 +15119,7 @@ var file_data_proto_depIdxs = []int32{
 	14,  // 28: User.newRealTimeIconsList:type_name -> Image
 	129, // 29: User.userAttr:type_name -> User.UserAttr
 	125, // 30: User.ownRoom:type_name -> User.OwnRoom
-	161, // 31: User.linkMicStats:type_name -> LinkmicStatus
+	163, // 31: User.linkMicStats:type_name -> LinkmicStatus
 	116, // 32: User.webcastAnchorLevel:type_name -> User.AnchorLevel
 	118, // 33: User.authorStats:type_name -> User.AuthorStats
 	17,  // 34: User.topFansList:type_name -> User
@@ -14940,213 +15138,217 @@ var file_data_proto_depIdxs = []int32{
 	114, // 47: User.upcomingEventList:type_name -> User.LiveEventInfo
 	121, // 48: User.ecommerceEntrance:type_name -> User.EcommerceEntrance
 	14,  // 49: Emote.image:type_name -> Image
-	162, // 50: Emote.auditStatus:type_name -> AuditStatus
-	163, // 51: Emote.emoteType:type_name -> EmoteType
-	164, // 52: Emote.contentSource:type_name -> ContentSource
-	165, // 53: Emote.emotePrivateType:type_name -> EmotePrivateType
-	140, // 54: Goal.contributorsList:type_name -> Goal.GoalContributor
-	139, // 55: Goal.stats:type_name -> Goal.GoalStats
-	25,  // 56: Ranking.color:type_name -> TikTokColor
-	26,  // 57: Ranking.details:type_name -> ValueLabel
-	25,  // 58: MessageDetails.color:type_name -> TikTokColor
-	28,  // 59: MessageDetails.user:type_name -> UserContainer
-	17,  // 60: UserContainer.user:type_name -> User
-	25,  // 61: MemberMessageData.color:type_name -> TikTokColor
-	27,  // 62: MemberMessageData.details:type_name -> MessageDetails
-	141, // 63: LinkMicArmiesItems.battleGroups:type_name -> LinkMicArmiesItems.LinkMicArmiesGroup
-	35,  // 64: PollStartContent.OptionList:type_name -> PollOptionInfo
-	17,  // 65: PollStartContent.Operator:type_name -> User
-	35,  // 66: PollEndContent.OptionList:type_name -> PollOptionInfo
-	17,  // 67: PollEndContent.Operator:type_name -> User
-	36,  // 68: PollOptionInfo.VoteUserList:type_name -> VoteUser
-	14,  // 69: VoteUser.AvatarThumb:type_name -> Image
-	35,  // 70: PollUpdateVotesContent.OptionList:type_name -> PollOptionInfo
-	38,  // 71: FanTicketRoomNoticeContent.UserFanTicketList:type_name -> UserFanTicket
-	17,  // 72: ListUser.user:type_name -> User
-	11,  // 73: ListUser.linkType:type_name -> ListUser.LinkType
-	42,  // 74: LinkerEnterContent.linkedUsersList:type_name -> ListUser
-	63,  // 75: LinkerEnterContent.anchorSettingInfo:type_name -> LinkmicUserSettingInfo
-	17,  // 76: LinkerInviteContent.fromUser:type_name -> User
-	166, // 77: LinkerKickOutContent.kickoutReason:type_name -> KickoutReason
-	42,  // 78: LinkerListChangeContent.linkedUsers:type_name -> ListUser
-	42,  // 79: LinkerListChangeContent.appliedUsers:type_name -> ListUser
-	42,  // 80: LinkerListChangeContent.connectingUsers:type_name -> ListUser
-	17,  // 81: LinkerRandomMatchContent.user:type_name -> User
-	142, // 82: LinkerReplyContent.fromUserLinkmicInfo:type_name -> LinkerReplyContent.LinkmicInfo
-	142, // 83: LinkerReplyContent.toUserLinkmicInfo:type_name -> LinkerReplyContent.LinkmicInfo
-	57,  // 84: LinkerReplyContent.linkerSetting:type_name -> LinkerSetting
-	17,  // 85: LinkerReplyContent.fromUser:type_name -> User
-	17,  // 86: LinkerReplyContent.toUser:type_name -> User
-	13,  // 87: LinkmicUserToastContent.displayText:type_name -> Text
-	167, // 88: LinkmicUserSettingInfo.applierSortSetting:type_name -> LinkmicApplierSortSetting
-	66,  // 89: AllListUser.linkedList:type_name -> LinkLayerListUser
-	66,  // 90: AllListUser.appliedList:type_name -> LinkLayerListUser
-	66,  // 91: AllListUser.invitedList:type_name -> LinkLayerListUser
-	66,  // 92: AllListUser.readyList:type_name -> LinkLayerListUser
-	17,  // 93: LinkLayerListUser.user:type_name -> User
-	67,  // 94: LinkLayerListUser.pos:type_name -> Position
-	68,  // 95: Position.link:type_name -> LinkPosition
-	17,  // 96: GroupPlayer.user:type_name -> User
-	72,  // 97: GroupChannelAllUser.userList:type_name -> GroupChannelUser
-	168, // 98: GroupChannelUser.status:type_name -> GroupStatus
-	169, // 99: GroupChannelUser.type:type_name -> TextType
-	65,  // 100: GroupChannelUser.allUser:type_name -> AllListUser
-	69,  // 101: GroupChannelUser.ownerUser:type_name -> GroupPlayer
-	143, // 102: RTCExtraInfo.liveRtcEngineConfig:type_name -> RTCExtraInfo.RTCEngineConfig
-	144, // 103: RTCExtraInfo.liveRtcVideoParamList:type_name -> RTCExtraInfo.RTCLiveVideoParam
-	146, // 104: RTCExtraInfo.rtcBitrateMap:type_name -> RTCExtraInfo.RTCBitrateMap
-	64,  // 105: CreateChannelContent.owner:type_name -> Player
-	169, // 106: ListChangeContent.type:type_name -> TextType
-	65,  // 107: ListChangeContent.list:type_name -> AllListUser
-	147, // 108: MultiLiveContent.inviteBizContent:type_name -> MultiLiveContent.InviteBizContent
-	148, // 109: MultiLiveContent.replyBizContent:type_name -> MultiLiveContent.ReplyBizContent
-	149, // 110: MultiLiveContent.permitBizContent:type_name -> MultiLiveContent.PermitBizContent
-	150, // 111: MultiLiveContent.kickOutBizContent:type_name -> MultiLiveContent.KickOutBizContent
-	64,  // 112: InviteContent.invitor:type_name -> Player
-	73,  // 113: InviteContent.inviteeRtcExtInfo:type_name -> RTCExtraInfo
-	67,  // 114: InviteContent.pos:type_name -> Position
-	70,  // 115: InviteContent.dsl:type_name -> DSLConfig
-	17,  // 116: InviteContent.invitee:type_name -> User
-	17,  // 117: InviteContent.operator:type_name -> User
-	64,  // 118: ApplyContent.applier:type_name -> Player
-	64,  // 119: PermitApplyContent.permiter:type_name -> Player
-	67,  // 120: PermitApplyContent.applierPos:type_name -> Position
-	170, // 121: PermitApplyContent.replyStatus:type_name -> ReplyStatus
-	70,  // 122: PermitApplyContent.dsl:type_name -> DSLConfig
-	17,  // 123: PermitApplyContent.applier:type_name -> User
-	17,  // 124: PermitApplyContent.operator:type_name -> User
-	64,  // 125: ReplyInviteContent.invitee:type_name -> Player
-	170, // 126: ReplyInviteContent.replyStatus:type_name -> ReplyStatus
-	67,  // 127: ReplyInviteContent.inviteePos:type_name -> Position
-	64,  // 128: ReplyInviteContent.inviteOperatorUser:type_name -> Player
-	64,  // 129: KickOutContent.offliner:type_name -> Player
-	166, // 130: KickOutContent.kickoutReason:type_name -> KickoutReason
-	64,  // 131: CancelApplyContent.applier:type_name -> Player
-	64,  // 132: CancelInviteContent.invitor:type_name -> Player
-	64,  // 133: CancelInviteContent.invitee:type_name -> Player
-	64,  // 134: LeaveContent.leaver:type_name -> Player
-	64,  // 135: FinishChannelContent.owner:type_name -> Player
-	66,  // 136: JoinDirectContent.joiner:type_name -> LinkLayerListUser
-	65,  // 137: JoinDirectContent.allUsers:type_name -> AllListUser
-	69,  // 138: LeaveJoinGroupContent.operator:type_name -> GroupPlayer
-	69,  // 139: PermitJoinGroupContent.approver:type_name -> GroupPlayer
-	171, // 140: PermitJoinGroupContent.agreeStatus:type_name -> AgreeStatus
-	169, // 141: PermitJoinGroupContent.type:type_name -> TextType
-	73,  // 142: PermitJoinGroupContent.groupExtInfoList:type_name -> RTCExtraInfo
-	71,  // 143: PermitJoinGroupContent.groupUser:type_name -> GroupChannelAllUser
-	69,  // 144: CancelJoinGroupContent.leaverList:type_name -> GroupPlayer
-	69,  // 145: CancelJoinGroupContent.operator:type_name -> GroupPlayer
-	169, // 146: CancelJoinGroupContent.type:type_name -> TextType
-	73,  // 147: P2PGroupChangeContent.groupExtInfoList:type_name -> RTCExtraInfo
-	71,  // 148: P2PGroupChangeContent.groupUser:type_name -> GroupChannelAllUser
-	76,  // 149: BusinessContent.multiLiveContent:type_name -> MultiLiveContent
-	151, // 150: BusinessContent.cohostContent:type_name -> BusinessContent.CohostContent
-	71,  // 151: JoinGroupContent.groupUser:type_name -> GroupChannelAllUser
-	69,  // 152: JoinGroupContent.joinUser:type_name -> GroupPlayer
-	169, // 153: JoinGroupContent.type:type_name -> TextType
-	94,  // 154: Common.LiveMessageSEI.uniqueId:type_name -> Common.LiveMessageID
-	96,  // 155: Text.TextPiece.format:type_name -> Text.TextFormat
-	99,  // 156: Text.TextPiece.userValue:type_name -> Text.TextPieceUser
-	97,  // 157: Text.TextPiece.giftValue:type_name -> Text.TextPieceGift
-	98,  // 158: Text.TextPiece.patternRefValue:type_name -> Text.TextPiecePatternRef
-	17,  // 159: Text.TextPieceUser.user:type_name -> User
-	14,  // 160: BadgeStruct.CombineBadge.icon:type_name -> Image
-	107, // 161: BadgeStruct.CombineBadge.text:type_name -> BadgeStruct.TextBadge
-	104, // 162: BadgeStruct.CombineBadge.profileCardPanel:type_name -> BadgeStruct.ProfileCardPanel
-	105, // 163: BadgeStruct.CombineBadge.background:type_name -> BadgeStruct.CombineBadgeBackground
-	105, // 164: BadgeStruct.CombineBadge.backgroundDarkMode:type_name -> BadgeStruct.CombineBadgeBackground
-	108, // 165: BadgeStruct.ProfileContent.iconList:type_name -> BadgeStruct.IconConfig
-	103, // 166: BadgeStruct.ProfileContent.numberConfig:type_name -> BadgeStruct.NumberConfig
-	14,  // 167: BadgeStruct.ProjectionConfig.icon:type_name -> Image
-	105, // 168: BadgeStruct.NumberConfig.background:type_name -> BadgeStruct.CombineBadgeBackground
-	102, // 169: BadgeStruct.ProfileCardPanel.projectionConfig:type_name -> BadgeStruct.ProjectionConfig
-	101, // 170: BadgeStruct.ProfileCardPanel.profileContent:type_name -> BadgeStruct.ProfileContent
-	14,  // 171: BadgeStruct.CombineBadgeBackground.image:type_name -> Image
-	14,  // 172: BadgeStruct.ImageBadge.image:type_name -> Image
-	14,  // 173: BadgeStruct.IconConfig.icon:type_name -> Image
-	105, // 174: BadgeStruct.IconConfig.background:type_name -> BadgeStruct.CombineBadgeBackground
-	13,  // 175: GiftStruct.GiftPanelBanner.displayText:type_name -> Text
-	14,  // 176: GiftStruct.GiftPanelBanner.leftIcon:type_name -> Image
-	113, // 177: GiftStruct.GiftRandomEffectInfo.randomGiftPanelBanner:type_name -> GiftStruct.RandomGiftPanelBanner
-	112, // 178: GiftStruct.GiftRandomEffectInfo.randomGiftBubble:type_name -> GiftStruct.RandomGiftBubble
-	14,  // 179: GiftStruct.RandomGiftBubble.iconDynamicEffect:type_name -> Image
-	14,  // 180: GiftStruct.RandomGiftPanelBanner.bgImage:type_name -> Image
-	14,  // 181: GiftStruct.RandomGiftPanelBanner.shadingImage:type_name -> Image
-	14,  // 182: GiftStruct.RandomGiftPanelBanner.leftIcon:type_name -> Image
-	14,  // 183: User.ActivityInfo.badge:type_name -> Image
-	14,  // 184: User.ActivityInfo.storytag:type_name -> Image
-	14,  // 185: User.AnchorLevel.profileDialogBg:type_name -> Image
-	14,  // 186: User.AnchorLevel.profileDialogBgBack:type_name -> Image
-	14,  // 187: User.AnchorLevel.stageLevel:type_name -> Image
-	14,  // 188: User.AnchorLevel.smallIcon:type_name -> Image
-	14,  // 189: User.AuthenticationInfo.authenticationBadge:type_name -> Image
-	14,  // 190: User.Border.icon:type_name -> Image
-	14,  // 191: User.Border.profileDecorationRibbon:type_name -> Image
-	14,  // 192: User.ComboBadgeInfo.icon:type_name -> Image
-	6,   // 193: User.EcommerceEntrance.entranceType:type_name -> User.EcommerceEntrance.EntranceType
-	5,   // 194: User.EcommerceEntrance.creatorType:type_name -> User.EcommerceEntrance.CreatorType
-	132, // 195: User.EcommerceEntrance.shopEntranceInfo:type_name -> User.EcommerceEntrance.ShopEntranceInfo
-	133, // 196: User.EcommerceEntrance.showcaseEntranceInfo:type_name -> User.EcommerceEntrance.ShowcaseEntranceInfo
-	137, // 197: User.FansClub.data:type_name -> User.FansClub.FansClubData
-	14,  // 198: User.FansClubInfo.badge:type_name -> Image
-	14,  // 199: User.PayGrade.diamondIcon:type_name -> Image
-	14,  // 200: User.PayGrade.icon:type_name -> Image
-	14,  // 201: User.PayGrade.nextIcon:type_name -> Image
-	138, // 202: User.PayGrade.gradeIconList:type_name -> User.PayGrade.GradeIcon
-	14,  // 203: User.PayGrade.imIcon:type_name -> Image
-	14,  // 204: User.PayGrade.imIconWithLevel:type_name -> Image
-	14,  // 205: User.PayGrade.liveIcon:type_name -> Image
-	14,  // 206: User.PayGrade.newImIconWithLevel:type_name -> Image
-	14,  // 207: User.PayGrade.newLiveIcon:type_name -> Image
-	14,  // 208: User.PayGrade.background:type_name -> Image
-	14,  // 209: User.PayGrade.backgroundBack:type_name -> Image
-	14,  // 210: User.PayGrade.profileDialogBg:type_name -> Image
-	14,  // 211: User.PayGrade.profileDialogBgBack:type_name -> Image
-	14,  // 212: User.SubscribeBadge.originImg:type_name -> Image
-	14,  // 213: User.SubscribeBadge.previewImg:type_name -> Image
-	127, // 214: User.SubscribeInfo.badge:type_name -> User.SubscribeBadge
-	134, // 215: User.EcommerceEntrance.ShopEntranceInfo.storeLabel:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel
-	135, // 216: User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.officialLabel:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel
-	136, // 217: User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.labelImageLight:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.ShopLabelImage
-	136, // 218: User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.labelImageDark:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.ShopLabelImage
-	10,  // 219: User.FansClub.FansClubData.userFansClubStatus:type_name -> User.FansClub.FansClubData.UserFansClubStatus
-	14,  // 220: User.PayGrade.GradeIcon.icon:type_name -> Image
-	14,  // 221: Goal.GoalContributor.avatar:type_name -> Image
-	15,  // 222: Goal.GoalContributor.badgeList:type_name -> BadgeStruct
-	17,  // 223: LinkMicArmiesItems.LinkMicArmiesGroup.users:type_name -> User
-	145, // 224: RTCExtraInfo.RTCLiveVideoParam.params:type_name -> RTCExtraInfo.RTCVideoParam
-	63,  // 225: MultiLiveContent.InviteBizContent.anchorSettingInfo:type_name -> LinkmicUserSettingInfo
-	17,  // 226: MultiLiveContent.InviteBizContent.operatorUserInfo:type_name -> User
-	17,  // 227: MultiLiveContent.InviteBizContent.inviteeUserInfo:type_name -> User
-	17,  // 228: MultiLiveContent.ReplyBizContent.replyUserInfo:type_name -> User
-	63,  // 229: MultiLiveContent.PermitBizContent.anchorSettingInfo:type_name -> LinkmicUserSettingInfo
-	17,  // 230: MultiLiveContent.PermitBizContent.operatorUserInfo:type_name -> User
-	17,  // 231: MultiLiveContent.KickOutBizContent.operatorUserInfo:type_name -> User
-	17,  // 232: MultiLiveContent.KickOutBizContent.kickPlayerUserInfo:type_name -> User
-	152, // 233: BusinessContent.CohostContent.joinGroupBizContent:type_name -> BusinessContent.JoinGroupBizContent
-	153, // 234: BusinessContent.JoinGroupBizContent.fromTag:type_name -> BusinessContent.Tag
-	154, // 235: BusinessContent.JoinGroupBizContent.dialog:type_name -> BusinessContent.PerceptionDialogInfo
-	19,  // 236: BusinessContent.JoinGroupBizContent.punishInfo:type_name -> PunishEventInfo
-	156, // 237: BusinessContent.JoinGroupBizContent.joinGroupMsgExtra:type_name -> BusinessContent.JoinGroupMessageExtra
-	13,  // 238: BusinessContent.PerceptionDialogInfo.title:type_name -> Text
-	13,  // 239: BusinessContent.PerceptionDialogInfo.subTitle:type_name -> Text
-	13,  // 240: BusinessContent.PerceptionDialogInfo.adviceActionText:type_name -> Text
-	13,  // 241: BusinessContent.PerceptionDialogInfo.defaultActionText:type_name -> Text
-	155, // 242: BusinessContent.PerceptionDialogInfo.feedbackOptionsList:type_name -> BusinessContent.PerceptionFeedbackOption
-	159, // 243: BusinessContent.JoinGroupMessageExtra.extra:type_name -> BusinessContent.JoinGroupMessageExtra.RivalExtra
-	159, // 244: BusinessContent.JoinGroupMessageExtra.otherUsersList:type_name -> BusinessContent.JoinGroupMessageExtra.RivalExtra
-	14,  // 245: BusinessContent.Hashtag.image:type_name -> Image
-	172, // 246: BusinessContent.Hashtag.namespace:type_name -> HashtagNamespace
-	14,  // 247: BusinessContent.JoinGroupMessageExtra.RivalExtra.avatarThumb:type_name -> Image
-	160, // 248: BusinessContent.JoinGroupMessageExtra.RivalExtra.authenticationInfo:type_name -> BusinessContent.JoinGroupMessageExtra.RivalExtra.AuthenticationInfo
-	157, // 249: BusinessContent.JoinGroupMessageExtra.RivalExtra.hashtag:type_name -> BusinessContent.Hashtag
-	158, // 250: BusinessContent.JoinGroupMessageExtra.RivalExtra.topHostInfo:type_name -> BusinessContent.TopHostInfo
-	14,  // 251: BusinessContent.JoinGroupMessageExtra.RivalExtra.AuthenticationInfo.authenticationBadge:type_name -> Image
-	252, // [252:252] is the sub-list for method output_type
-	252, // [252:252] is the sub-list for method input_type
-	252, // [252:252] is the sub-list for extension type_name
-	252, // [252:252] is the sub-list for extension extendee
-	0,   // [0:252] is the sub-list for field type_name
+	164, // 50: Emote.auditStatus:type_name -> AuditStatus
+	165, // 51: Emote.emoteType:type_name -> EmoteType
+	166, // 52: Emote.contentSource:type_name -> ContentSource
+	167, // 53: Emote.emotePrivateType:type_name -> EmotePrivateType
+	140, // 54: Goal.subGoalsList:type_name -> Goal.SubGoal
+	142, // 55: Goal.contributorsList:type_name -> Goal.GoalContributor
+	139, // 56: Goal.stats:type_name -> Goal.GoalStats
+	25,  // 57: Ranking.color:type_name -> TikTokColor
+	26,  // 58: Ranking.details:type_name -> ValueLabel
+	25,  // 59: MessageDetails.color:type_name -> TikTokColor
+	28,  // 60: MessageDetails.user:type_name -> UserContainer
+	17,  // 61: UserContainer.user:type_name -> User
+	25,  // 62: MemberMessageData.color:type_name -> TikTokColor
+	27,  // 63: MemberMessageData.details:type_name -> MessageDetails
+	143, // 64: LinkMicArmiesItems.battleGroups:type_name -> LinkMicArmiesItems.LinkMicArmiesGroup
+	35,  // 65: PollStartContent.OptionList:type_name -> PollOptionInfo
+	17,  // 66: PollStartContent.Operator:type_name -> User
+	35,  // 67: PollEndContent.OptionList:type_name -> PollOptionInfo
+	17,  // 68: PollEndContent.Operator:type_name -> User
+	36,  // 69: PollOptionInfo.VoteUserList:type_name -> VoteUser
+	14,  // 70: VoteUser.AvatarThumb:type_name -> Image
+	35,  // 71: PollUpdateVotesContent.OptionList:type_name -> PollOptionInfo
+	38,  // 72: FanTicketRoomNoticeContent.UserFanTicketList:type_name -> UserFanTicket
+	17,  // 73: ListUser.user:type_name -> User
+	11,  // 74: ListUser.linkType:type_name -> ListUser.LinkType
+	42,  // 75: LinkerEnterContent.linkedUsersList:type_name -> ListUser
+	63,  // 76: LinkerEnterContent.anchorSettingInfo:type_name -> LinkmicUserSettingInfo
+	17,  // 77: LinkerInviteContent.fromUser:type_name -> User
+	168, // 78: LinkerKickOutContent.kickoutReason:type_name -> KickoutReason
+	42,  // 79: LinkerListChangeContent.linkedUsers:type_name -> ListUser
+	42,  // 80: LinkerListChangeContent.appliedUsers:type_name -> ListUser
+	42,  // 81: LinkerListChangeContent.connectingUsers:type_name -> ListUser
+	17,  // 82: LinkerRandomMatchContent.user:type_name -> User
+	144, // 83: LinkerReplyContent.fromUserLinkmicInfo:type_name -> LinkerReplyContent.LinkmicInfo
+	144, // 84: LinkerReplyContent.toUserLinkmicInfo:type_name -> LinkerReplyContent.LinkmicInfo
+	57,  // 85: LinkerReplyContent.linkerSetting:type_name -> LinkerSetting
+	17,  // 86: LinkerReplyContent.fromUser:type_name -> User
+	17,  // 87: LinkerReplyContent.toUser:type_name -> User
+	13,  // 88: LinkmicUserToastContent.displayText:type_name -> Text
+	169, // 89: LinkmicUserSettingInfo.applierSortSetting:type_name -> LinkmicApplierSortSetting
+	66,  // 90: AllListUser.linkedList:type_name -> LinkLayerListUser
+	66,  // 91: AllListUser.appliedList:type_name -> LinkLayerListUser
+	66,  // 92: AllListUser.invitedList:type_name -> LinkLayerListUser
+	66,  // 93: AllListUser.readyList:type_name -> LinkLayerListUser
+	17,  // 94: LinkLayerListUser.user:type_name -> User
+	67,  // 95: LinkLayerListUser.pos:type_name -> Position
+	68,  // 96: Position.link:type_name -> LinkPosition
+	17,  // 97: GroupPlayer.user:type_name -> User
+	72,  // 98: GroupChannelAllUser.userList:type_name -> GroupChannelUser
+	170, // 99: GroupChannelUser.status:type_name -> GroupStatus
+	171, // 100: GroupChannelUser.type:type_name -> TextType
+	65,  // 101: GroupChannelUser.allUser:type_name -> AllListUser
+	69,  // 102: GroupChannelUser.ownerUser:type_name -> GroupPlayer
+	145, // 103: RTCExtraInfo.liveRtcEngineConfig:type_name -> RTCExtraInfo.RTCEngineConfig
+	146, // 104: RTCExtraInfo.liveRtcVideoParamList:type_name -> RTCExtraInfo.RTCLiveVideoParam
+	148, // 105: RTCExtraInfo.rtcBitrateMap:type_name -> RTCExtraInfo.RTCBitrateMap
+	64,  // 106: CreateChannelContent.owner:type_name -> Player
+	171, // 107: ListChangeContent.type:type_name -> TextType
+	65,  // 108: ListChangeContent.list:type_name -> AllListUser
+	149, // 109: MultiLiveContent.inviteBizContent:type_name -> MultiLiveContent.InviteBizContent
+	150, // 110: MultiLiveContent.replyBizContent:type_name -> MultiLiveContent.ReplyBizContent
+	151, // 111: MultiLiveContent.permitBizContent:type_name -> MultiLiveContent.PermitBizContent
+	152, // 112: MultiLiveContent.kickOutBizContent:type_name -> MultiLiveContent.KickOutBizContent
+	64,  // 113: InviteContent.invitor:type_name -> Player
+	73,  // 114: InviteContent.inviteeRtcExtInfo:type_name -> RTCExtraInfo
+	67,  // 115: InviteContent.pos:type_name -> Position
+	70,  // 116: InviteContent.dsl:type_name -> DSLConfig
+	17,  // 117: InviteContent.invitee:type_name -> User
+	17,  // 118: InviteContent.operator:type_name -> User
+	64,  // 119: ApplyContent.applier:type_name -> Player
+	64,  // 120: PermitApplyContent.permiter:type_name -> Player
+	67,  // 121: PermitApplyContent.applierPos:type_name -> Position
+	172, // 122: PermitApplyContent.replyStatus:type_name -> ReplyStatus
+	70,  // 123: PermitApplyContent.dsl:type_name -> DSLConfig
+	17,  // 124: PermitApplyContent.applier:type_name -> User
+	17,  // 125: PermitApplyContent.operator:type_name -> User
+	64,  // 126: ReplyInviteContent.invitee:type_name -> Player
+	172, // 127: ReplyInviteContent.replyStatus:type_name -> ReplyStatus
+	67,  // 128: ReplyInviteContent.inviteePos:type_name -> Position
+	64,  // 129: ReplyInviteContent.inviteOperatorUser:type_name -> Player
+	64,  // 130: KickOutContent.offliner:type_name -> Player
+	168, // 131: KickOutContent.kickoutReason:type_name -> KickoutReason
+	64,  // 132: CancelApplyContent.applier:type_name -> Player
+	64,  // 133: CancelInviteContent.invitor:type_name -> Player
+	64,  // 134: CancelInviteContent.invitee:type_name -> Player
+	64,  // 135: LeaveContent.leaver:type_name -> Player
+	64,  // 136: FinishChannelContent.owner:type_name -> Player
+	66,  // 137: JoinDirectContent.joiner:type_name -> LinkLayerListUser
+	65,  // 138: JoinDirectContent.allUsers:type_name -> AllListUser
+	69,  // 139: LeaveJoinGroupContent.operator:type_name -> GroupPlayer
+	69,  // 140: PermitJoinGroupContent.approver:type_name -> GroupPlayer
+	173, // 141: PermitJoinGroupContent.agreeStatus:type_name -> AgreeStatus
+	171, // 142: PermitJoinGroupContent.type:type_name -> TextType
+	73,  // 143: PermitJoinGroupContent.groupExtInfoList:type_name -> RTCExtraInfo
+	71,  // 144: PermitJoinGroupContent.groupUser:type_name -> GroupChannelAllUser
+	69,  // 145: CancelJoinGroupContent.leaverList:type_name -> GroupPlayer
+	69,  // 146: CancelJoinGroupContent.operator:type_name -> GroupPlayer
+	171, // 147: CancelJoinGroupContent.type:type_name -> TextType
+	73,  // 148: P2PGroupChangeContent.groupExtInfoList:type_name -> RTCExtraInfo
+	71,  // 149: P2PGroupChangeContent.groupUser:type_name -> GroupChannelAllUser
+	76,  // 150: BusinessContent.multiLiveContent:type_name -> MultiLiveContent
+	153, // 151: BusinessContent.cohostContent:type_name -> BusinessContent.CohostContent
+	71,  // 152: JoinGroupContent.groupUser:type_name -> GroupChannelAllUser
+	69,  // 153: JoinGroupContent.joinUser:type_name -> GroupPlayer
+	171, // 154: JoinGroupContent.type:type_name -> TextType
+	94,  // 155: Common.LiveMessageSEI.uniqueId:type_name -> Common.LiveMessageID
+	96,  // 156: Text.TextPiece.format:type_name -> Text.TextFormat
+	99,  // 157: Text.TextPiece.userValue:type_name -> Text.TextPieceUser
+	97,  // 158: Text.TextPiece.giftValue:type_name -> Text.TextPieceGift
+	98,  // 159: Text.TextPiece.patternRefValue:type_name -> Text.TextPiecePatternRef
+	98,  // 160: Text.TextPieceGift.nameRef:type_name -> Text.TextPiecePatternRef
+	17,  // 161: Text.TextPieceUser.user:type_name -> User
+	14,  // 162: BadgeStruct.CombineBadge.icon:type_name -> Image
+	107, // 163: BadgeStruct.CombineBadge.text:type_name -> BadgeStruct.TextBadge
+	104, // 164: BadgeStruct.CombineBadge.profileCardPanel:type_name -> BadgeStruct.ProfileCardPanel
+	105, // 165: BadgeStruct.CombineBadge.background:type_name -> BadgeStruct.CombineBadgeBackground
+	105, // 166: BadgeStruct.CombineBadge.backgroundDarkMode:type_name -> BadgeStruct.CombineBadgeBackground
+	108, // 167: BadgeStruct.ProfileContent.iconList:type_name -> BadgeStruct.IconConfig
+	103, // 168: BadgeStruct.ProfileContent.numberConfig:type_name -> BadgeStruct.NumberConfig
+	14,  // 169: BadgeStruct.ProjectionConfig.icon:type_name -> Image
+	105, // 170: BadgeStruct.NumberConfig.background:type_name -> BadgeStruct.CombineBadgeBackground
+	102, // 171: BadgeStruct.ProfileCardPanel.projectionConfig:type_name -> BadgeStruct.ProjectionConfig
+	101, // 172: BadgeStruct.ProfileCardPanel.profileContent:type_name -> BadgeStruct.ProfileContent
+	14,  // 173: BadgeStruct.CombineBadgeBackground.image:type_name -> Image
+	14,  // 174: BadgeStruct.ImageBadge.image:type_name -> Image
+	14,  // 175: BadgeStruct.IconConfig.icon:type_name -> Image
+	105, // 176: BadgeStruct.IconConfig.background:type_name -> BadgeStruct.CombineBadgeBackground
+	13,  // 177: GiftStruct.GiftPanelBanner.displayText:type_name -> Text
+	14,  // 178: GiftStruct.GiftPanelBanner.leftIcon:type_name -> Image
+	113, // 179: GiftStruct.GiftRandomEffectInfo.randomGiftPanelBanner:type_name -> GiftStruct.RandomGiftPanelBanner
+	112, // 180: GiftStruct.GiftRandomEffectInfo.randomGiftBubble:type_name -> GiftStruct.RandomGiftBubble
+	14,  // 181: GiftStruct.RandomGiftBubble.iconDynamicEffect:type_name -> Image
+	14,  // 182: GiftStruct.RandomGiftPanelBanner.bgImage:type_name -> Image
+	14,  // 183: GiftStruct.RandomGiftPanelBanner.shadingImage:type_name -> Image
+	14,  // 184: GiftStruct.RandomGiftPanelBanner.leftIcon:type_name -> Image
+	14,  // 185: User.ActivityInfo.badge:type_name -> Image
+	14,  // 186: User.ActivityInfo.storytag:type_name -> Image
+	14,  // 187: User.AnchorLevel.profileDialogBg:type_name -> Image
+	14,  // 188: User.AnchorLevel.profileDialogBgBack:type_name -> Image
+	14,  // 189: User.AnchorLevel.stageLevel:type_name -> Image
+	14,  // 190: User.AnchorLevel.smallIcon:type_name -> Image
+	14,  // 191: User.AuthenticationInfo.authenticationBadge:type_name -> Image
+	14,  // 192: User.Border.icon:type_name -> Image
+	14,  // 193: User.Border.profileDecorationRibbon:type_name -> Image
+	14,  // 194: User.ComboBadgeInfo.icon:type_name -> Image
+	6,   // 195: User.EcommerceEntrance.entranceType:type_name -> User.EcommerceEntrance.EntranceType
+	5,   // 196: User.EcommerceEntrance.creatorType:type_name -> User.EcommerceEntrance.CreatorType
+	132, // 197: User.EcommerceEntrance.shopEntranceInfo:type_name -> User.EcommerceEntrance.ShopEntranceInfo
+	133, // 198: User.EcommerceEntrance.showcaseEntranceInfo:type_name -> User.EcommerceEntrance.ShowcaseEntranceInfo
+	137, // 199: User.FansClub.data:type_name -> User.FansClub.FansClubData
+	14,  // 200: User.FansClubInfo.badge:type_name -> Image
+	14,  // 201: User.PayGrade.diamondIcon:type_name -> Image
+	14,  // 202: User.PayGrade.icon:type_name -> Image
+	14,  // 203: User.PayGrade.nextIcon:type_name -> Image
+	138, // 204: User.PayGrade.gradeIconList:type_name -> User.PayGrade.GradeIcon
+	14,  // 205: User.PayGrade.imIcon:type_name -> Image
+	14,  // 206: User.PayGrade.imIconWithLevel:type_name -> Image
+	14,  // 207: User.PayGrade.liveIcon:type_name -> Image
+	14,  // 208: User.PayGrade.newImIconWithLevel:type_name -> Image
+	14,  // 209: User.PayGrade.newLiveIcon:type_name -> Image
+	14,  // 210: User.PayGrade.background:type_name -> Image
+	14,  // 211: User.PayGrade.backgroundBack:type_name -> Image
+	14,  // 212: User.PayGrade.profileDialogBg:type_name -> Image
+	14,  // 213: User.PayGrade.profileDialogBgBack:type_name -> Image
+	14,  // 214: User.SubscribeBadge.originImg:type_name -> Image
+	14,  // 215: User.SubscribeBadge.previewImg:type_name -> Image
+	127, // 216: User.SubscribeInfo.badge:type_name -> User.SubscribeBadge
+	134, // 217: User.EcommerceEntrance.ShopEntranceInfo.storeLabel:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel
+	135, // 218: User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.officialLabel:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel
+	136, // 219: User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.labelImageLight:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.ShopLabelImage
+	136, // 220: User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.labelImageDark:type_name -> User.EcommerceEntrance.ShopEntranceInfo.StoreLabel.StoreOfficialLabel.ShopLabelImage
+	10,  // 221: User.FansClub.FansClubData.userFansClubStatus:type_name -> User.FansClub.FansClubData.UserFansClubStatus
+	14,  // 222: User.PayGrade.GradeIcon.icon:type_name -> Image
+	141, // 223: Goal.SubGoal.gift:type_name -> Goal.SubGoalGift
+	14,  // 224: Goal.SubGoalGift.icon:type_name -> Image
+	14,  // 225: Goal.GoalContributor.avatar:type_name -> Image
+	15,  // 226: Goal.GoalContributor.badgeList:type_name -> BadgeStruct
+	17,  // 227: LinkMicArmiesItems.LinkMicArmiesGroup.users:type_name -> User
+	147, // 228: RTCExtraInfo.RTCLiveVideoParam.params:type_name -> RTCExtraInfo.RTCVideoParam
+	63,  // 229: MultiLiveContent.InviteBizContent.anchorSettingInfo:type_name -> LinkmicUserSettingInfo
+	17,  // 230: MultiLiveContent.InviteBizContent.operatorUserInfo:type_name -> User
+	17,  // 231: MultiLiveContent.InviteBizContent.inviteeUserInfo:type_name -> User
+	17,  // 232: MultiLiveContent.ReplyBizContent.replyUserInfo:type_name -> User
+	63,  // 233: MultiLiveContent.PermitBizContent.anchorSettingInfo:type_name -> LinkmicUserSettingInfo
+	17,  // 234: MultiLiveContent.PermitBizContent.operatorUserInfo:type_name -> User
+	17,  // 235: MultiLiveContent.KickOutBizContent.operatorUserInfo:type_name -> User
+	17,  // 236: MultiLiveContent.KickOutBizContent.kickPlayerUserInfo:type_name -> User
+	154, // 237: BusinessContent.CohostContent.joinGroupBizContent:type_name -> BusinessContent.JoinGroupBizContent
+	155, // 238: BusinessContent.JoinGroupBizContent.fromTag:type_name -> BusinessContent.Tag
+	156, // 239: BusinessContent.JoinGroupBizContent.dialog:type_name -> BusinessContent.PerceptionDialogInfo
+	19,  // 240: BusinessContent.JoinGroupBizContent.punishInfo:type_name -> PunishEventInfo
+	158, // 241: BusinessContent.JoinGroupBizContent.joinGroupMsgExtra:type_name -> BusinessContent.JoinGroupMessageExtra
+	13,  // 242: BusinessContent.PerceptionDialogInfo.title:type_name -> Text
+	13,  // 243: BusinessContent.PerceptionDialogInfo.subTitle:type_name -> Text
+	13,  // 244: BusinessContent.PerceptionDialogInfo.adviceActionText:type_name -> Text
+	13,  // 245: BusinessContent.PerceptionDialogInfo.defaultActionText:type_name -> Text
+	157, // 246: BusinessContent.PerceptionDialogInfo.feedbackOptionsList:type_name -> BusinessContent.PerceptionFeedbackOption
+	161, // 247: BusinessContent.JoinGroupMessageExtra.extra:type_name -> BusinessContent.JoinGroupMessageExtra.RivalExtra
+	161, // 248: BusinessContent.JoinGroupMessageExtra.otherUsersList:type_name -> BusinessContent.JoinGroupMessageExtra.RivalExtra
+	14,  // 249: BusinessContent.Hashtag.image:type_name -> Image
+	174, // 250: BusinessContent.Hashtag.namespace:type_name -> HashtagNamespace
+	14,  // 251: BusinessContent.JoinGroupMessageExtra.RivalExtra.avatarThumb:type_name -> Image
+	162, // 252: BusinessContent.JoinGroupMessageExtra.RivalExtra.authenticationInfo:type_name -> BusinessContent.JoinGroupMessageExtra.RivalExtra.AuthenticationInfo
+	159, // 253: BusinessContent.JoinGroupMessageExtra.RivalExtra.hashtag:type_name -> BusinessContent.Hashtag
+	160, // 254: BusinessContent.JoinGroupMessageExtra.RivalExtra.topHostInfo:type_name -> BusinessContent.TopHostInfo
+	14,  // 255: BusinessContent.JoinGroupMessageExtra.RivalExtra.AuthenticationInfo.authenticationBadge:type_name -> Image
+	256, // [256:256] is the sub-list for method output_type
+	256, // [256:256] is the sub-list for method input_type
+	256, // [256:256] is the sub-list for extension type_name
+	256, // [256:256] is the sub-list for extension extendee
+	0,   // [0:256] is the sub-list for field type_name
 }
 
 func init() { file_data_proto_init() }
@@ -16693,7 +16895,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[128].Exporter = func(v any, i int) any {
-			switch v := v.(*Goal_GoalContributor); i {
+			switch v := v.(*Goal_SubGoal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16705,7 +16907,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[129].Exporter = func(v any, i int) any {
-			switch v := v.(*LinkMicArmiesItems_LinkMicArmiesGroup); i {
+			switch v := v.(*Goal_SubGoalGift); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16717,7 +16919,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[130].Exporter = func(v any, i int) any {
-			switch v := v.(*LinkerReplyContent_LinkmicInfo); i {
+			switch v := v.(*Goal_GoalContributor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16729,7 +16931,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[131].Exporter = func(v any, i int) any {
-			switch v := v.(*RTCExtraInfo_RTCEngineConfig); i {
+			switch v := v.(*LinkMicArmiesItems_LinkMicArmiesGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16741,7 +16943,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[132].Exporter = func(v any, i int) any {
-			switch v := v.(*RTCExtraInfo_RTCLiveVideoParam); i {
+			switch v := v.(*LinkerReplyContent_LinkmicInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16753,7 +16955,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[133].Exporter = func(v any, i int) any {
-			switch v := v.(*RTCExtraInfo_RTCVideoParam); i {
+			switch v := v.(*RTCExtraInfo_RTCEngineConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16765,7 +16967,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[134].Exporter = func(v any, i int) any {
-			switch v := v.(*RTCExtraInfo_RTCBitrateMap); i {
+			switch v := v.(*RTCExtraInfo_RTCLiveVideoParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16777,7 +16979,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[135].Exporter = func(v any, i int) any {
-			switch v := v.(*MultiLiveContent_InviteBizContent); i {
+			switch v := v.(*RTCExtraInfo_RTCVideoParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16789,7 +16991,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[136].Exporter = func(v any, i int) any {
-			switch v := v.(*MultiLiveContent_ReplyBizContent); i {
+			switch v := v.(*RTCExtraInfo_RTCBitrateMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16801,7 +17003,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[137].Exporter = func(v any, i int) any {
-			switch v := v.(*MultiLiveContent_PermitBizContent); i {
+			switch v := v.(*MultiLiveContent_InviteBizContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16813,7 +17015,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[138].Exporter = func(v any, i int) any {
-			switch v := v.(*MultiLiveContent_KickOutBizContent); i {
+			switch v := v.(*MultiLiveContent_ReplyBizContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16825,7 +17027,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[139].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_CohostContent); i {
+			switch v := v.(*MultiLiveContent_PermitBizContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16837,7 +17039,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[140].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_JoinGroupBizContent); i {
+			switch v := v.(*MultiLiveContent_KickOutBizContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16849,7 +17051,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[141].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_Tag); i {
+			switch v := v.(*BusinessContent_CohostContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16861,7 +17063,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[142].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_PerceptionDialogInfo); i {
+			switch v := v.(*BusinessContent_JoinGroupBizContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16873,7 +17075,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[143].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_PerceptionFeedbackOption); i {
+			switch v := v.(*BusinessContent_Tag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16885,7 +17087,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[144].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_JoinGroupMessageExtra); i {
+			switch v := v.(*BusinessContent_PerceptionDialogInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16897,7 +17099,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[145].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_Hashtag); i {
+			switch v := v.(*BusinessContent_PerceptionFeedbackOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16909,7 +17111,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[146].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_TopHostInfo); i {
+			switch v := v.(*BusinessContent_JoinGroupMessageExtra); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16921,7 +17123,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[147].Exporter = func(v any, i int) any {
-			switch v := v.(*BusinessContent_JoinGroupMessageExtra_RivalExtra); i {
+			switch v := v.(*BusinessContent_Hashtag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16933,6 +17135,30 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*BusinessContent_TopHostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[149].Exporter = func(v any, i int) any {
+			switch v := v.(*BusinessContent_JoinGroupMessageExtra_RivalExtra); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[150].Exporter = func(v any, i int) any {
 			switch v := v.(*BusinessContent_JoinGroupMessageExtra_RivalExtra_AuthenticationInfo); i {
 			case 0:
 				return &v.state
@@ -16961,7 +17187,7 @@ func file_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_data_proto_rawDesc,
 			NumEnums:      12,
-			NumMessages:   149,
+			NumMessages:   151,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -98,7 +98,7 @@ func (x WebcastBarrageMessage_BarrageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WebcastBarrageMessage_BarrageType.Descriptor instead.
 func (WebcastBarrageMessage_BarrageType) EnumDescriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{5, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{6, 0}
 }
 
 // @WebcastPushFrame
@@ -351,6 +351,54 @@ func (x *WebcastResponse) GetHistoryNoMore() bool {
 	return false
 }
 
+// Common field every Server-Message starts with, to read it without knowing the type of the message
+type WebcastMessageCommon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Common *Common `protobuf:"bytes,1,opt,name=common,proto3" json:"common,omitempty"`
+}
+
+func (x *WebcastMessageCommon) Reset() {
+	*x = WebcastMessageCommon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webcast_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebcastMessageCommon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebcastMessageCommon) ProtoMessage() {}
+
+func (x *WebcastMessageCommon) ProtoReflect() protoreflect.Message {
+	mi := &file_webcast_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebcastMessageCommon.ProtoReflect.Descriptor instead.
+func (*WebcastMessageCommon) Descriptor() ([]byte, []int) {
+	return file_webcast_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebcastMessageCommon) GetCommon() *Common {
+	if x != nil {
+		return x.Common
+	}
+	return nil
+}
+
 // @GiftMessage
 type WebcastGiftMessage struct {
 	state         protoimpl.MessageState
@@ -383,7 +431,7 @@ type WebcastGiftMessage struct {
 func (x *WebcastGiftMessage) Reset() {
 	*x = WebcastGiftMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[2]
+		mi := &file_webcast_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +444,7 @@ func (x *WebcastGiftMessage) String() string {
 func (*WebcastGiftMessage) ProtoMessage() {}
 
 func (x *WebcastGiftMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[2]
+	mi := &file_webcast_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +457,7 @@ func (x *WebcastGiftMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastGiftMessage.ProtoReflect.Descriptor instead.
 func (*WebcastGiftMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{2}
+	return file_webcast_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WebcastGiftMessage) GetCommon() *Common {
@@ -577,7 +625,7 @@ type RoomMessage struct {
 func (x *RoomMessage) Reset() {
 	*x = RoomMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[3]
+		mi := &file_webcast_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -590,7 +638,7 @@ func (x *RoomMessage) String() string {
 func (*RoomMessage) ProtoMessage() {}
 
 func (x *RoomMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[3]
+	mi := &file_webcast_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +651,7 @@ func (x *RoomMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomMessage.ProtoReflect.Descriptor instead.
 func (*RoomMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{3}
+	return file_webcast_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RoomMessage) GetCommon() *Common {
@@ -668,7 +716,7 @@ type WebcastRoomMessage struct {
 func (x *WebcastRoomMessage) Reset() {
 	*x = WebcastRoomMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[4]
+		mi := &file_webcast_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -681,7 +729,7 @@ func (x *WebcastRoomMessage) String() string {
 func (*WebcastRoomMessage) ProtoMessage() {}
 
 func (x *WebcastRoomMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[4]
+	mi := &file_webcast_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +742,7 @@ func (x *WebcastRoomMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastRoomMessage.ProtoReflect.Descriptor instead.
 func (*WebcastRoomMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{4}
+	return file_webcast_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WebcastRoomMessage) GetCommon() *Common {
@@ -733,7 +781,7 @@ type WebcastBarrageMessage struct {
 func (x *WebcastBarrageMessage) Reset() {
 	*x = WebcastBarrageMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[5]
+		mi := &file_webcast_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -746,7 +794,7 @@ func (x *WebcastBarrageMessage) String() string {
 func (*WebcastBarrageMessage) ProtoMessage() {}
 
 func (x *WebcastBarrageMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[5]
+	mi := &file_webcast_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +807,7 @@ func (x *WebcastBarrageMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastBarrageMessage.ProtoReflect.Descriptor instead.
 func (*WebcastBarrageMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{5}
+	return file_webcast_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *WebcastBarrageMessage) GetCommon() *Common {
@@ -854,7 +902,7 @@ type WebcastCaptionMessage struct {
 func (x *WebcastCaptionMessage) Reset() {
 	*x = WebcastCaptionMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[6]
+		mi := &file_webcast_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -867,7 +915,7 @@ func (x *WebcastCaptionMessage) String() string {
 func (*WebcastCaptionMessage) ProtoMessage() {}
 
 func (x *WebcastCaptionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[6]
+	mi := &file_webcast_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +928,7 @@ func (x *WebcastCaptionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastCaptionMessage.ProtoReflect.Descriptor instead.
 func (*WebcastCaptionMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{6}
+	return file_webcast_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WebcastCaptionMessage) GetCommon() *Common {
@@ -932,7 +980,7 @@ type WebcastChatMessage struct {
 func (x *WebcastChatMessage) Reset() {
 	*x = WebcastChatMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[7]
+		mi := &file_webcast_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +993,7 @@ func (x *WebcastChatMessage) String() string {
 func (*WebcastChatMessage) ProtoMessage() {}
 
 func (x *WebcastChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[7]
+	mi := &file_webcast_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1006,7 @@ func (x *WebcastChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastChatMessage.ProtoReflect.Descriptor instead.
 func (*WebcastChatMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{7}
+	return file_webcast_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WebcastChatMessage) GetCommon() *Common {
@@ -1094,7 +1142,7 @@ type WebcastControlMessage struct {
 func (x *WebcastControlMessage) Reset() {
 	*x = WebcastControlMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[8]
+		mi := &file_webcast_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1107,7 +1155,7 @@ func (x *WebcastControlMessage) String() string {
 func (*WebcastControlMessage) ProtoMessage() {}
 
 func (x *WebcastControlMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[8]
+	mi := &file_webcast_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1168,7 @@ func (x *WebcastControlMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastControlMessage.ProtoReflect.Descriptor instead.
 func (*WebcastControlMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{8}
+	return file_webcast_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WebcastControlMessage) GetCommon() *Common {
@@ -1196,7 +1244,7 @@ type WebcastEmoteChatMessage struct {
 func (x *WebcastEmoteChatMessage) Reset() {
 	*x = WebcastEmoteChatMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[9]
+		mi := &file_webcast_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1209,7 +1257,7 @@ func (x *WebcastEmoteChatMessage) String() string {
 func (*WebcastEmoteChatMessage) ProtoMessage() {}
 
 func (x *WebcastEmoteChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[9]
+	mi := &file_webcast_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1270,7 @@ func (x *WebcastEmoteChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastEmoteChatMessage.ProtoReflect.Descriptor instead.
 func (*WebcastEmoteChatMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{9}
+	return file_webcast_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WebcastEmoteChatMessage) GetCommon() *Common {
@@ -1274,7 +1322,7 @@ type WebcastEnvelopeMessage struct {
 func (x *WebcastEnvelopeMessage) Reset() {
 	*x = WebcastEnvelopeMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[10]
+		mi := &file_webcast_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1287,7 +1335,7 @@ func (x *WebcastEnvelopeMessage) String() string {
 func (*WebcastEnvelopeMessage) ProtoMessage() {}
 
 func (x *WebcastEnvelopeMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[10]
+	mi := &file_webcast_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +1348,7 @@ func (x *WebcastEnvelopeMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastEnvelopeMessage.ProtoReflect.Descriptor instead.
 func (*WebcastEnvelopeMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{10}
+	return file_webcast_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WebcastEnvelopeMessage) GetCommon() *Common {
@@ -1348,7 +1396,7 @@ type WebcastGoalUpdateMessage struct {
 func (x *WebcastGoalUpdateMessage) Reset() {
 	*x = WebcastGoalUpdateMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[11]
+		mi := &file_webcast_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +1409,7 @@ func (x *WebcastGoalUpdateMessage) String() string {
 func (*WebcastGoalUpdateMessage) ProtoMessage() {}
 
 func (x *WebcastGoalUpdateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[11]
+	mi := &file_webcast_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1422,7 @@ func (x *WebcastGoalUpdateMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastGoalUpdateMessage.ProtoReflect.Descriptor instead.
 func (*WebcastGoalUpdateMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{11}
+	return file_webcast_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *WebcastGoalUpdateMessage) GetCommon() *Common {
@@ -1476,7 +1524,7 @@ type WebcastImDeleteMessage struct {
 func (x *WebcastImDeleteMessage) Reset() {
 	*x = WebcastImDeleteMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[12]
+		mi := &file_webcast_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1489,7 +1537,7 @@ func (x *WebcastImDeleteMessage) String() string {
 func (*WebcastImDeleteMessage) ProtoMessage() {}
 
 func (x *WebcastImDeleteMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[12]
+	mi := &file_webcast_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,7 +1550,7 @@ func (x *WebcastImDeleteMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastImDeleteMessage.ProtoReflect.Descriptor instead.
 func (*WebcastImDeleteMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{12}
+	return file_webcast_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WebcastImDeleteMessage) GetCommon() *Common {
@@ -1539,7 +1587,7 @@ type WebcastInRoomBannerMessage struct {
 func (x *WebcastInRoomBannerMessage) Reset() {
 	*x = WebcastInRoomBannerMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[13]
+		mi := &file_webcast_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1552,7 +1600,7 @@ func (x *WebcastInRoomBannerMessage) String() string {
 func (*WebcastInRoomBannerMessage) ProtoMessage() {}
 
 func (x *WebcastInRoomBannerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[13]
+	mi := &file_webcast_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1565,7 +1613,7 @@ func (x *WebcastInRoomBannerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastInRoomBannerMessage.ProtoReflect.Descriptor instead.
 func (*WebcastInRoomBannerMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{13}
+	return file_webcast_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WebcastInRoomBannerMessage) GetHeader() *Common {
@@ -1598,7 +1646,7 @@ type WebcastLikeMessage struct {
 func (x *WebcastLikeMessage) Reset() {
 	*x = WebcastLikeMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[14]
+		mi := &file_webcast_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1611,7 +1659,7 @@ func (x *WebcastLikeMessage) String() string {
 func (*WebcastLikeMessage) ProtoMessage() {}
 
 func (x *WebcastLikeMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[14]
+	mi := &file_webcast_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,7 +1672,7 @@ func (x *WebcastLikeMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLikeMessage.ProtoReflect.Descriptor instead.
 func (*WebcastLikeMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{14}
+	return file_webcast_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *WebcastLikeMessage) GetCommon() *Common {
@@ -1675,7 +1723,7 @@ type WebcastRoomUserSeqMessage struct {
 func (x *WebcastRoomUserSeqMessage) Reset() {
 	*x = WebcastRoomUserSeqMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[15]
+		mi := &file_webcast_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1688,7 +1736,7 @@ func (x *WebcastRoomUserSeqMessage) String() string {
 func (*WebcastRoomUserSeqMessage) ProtoMessage() {}
 
 func (x *WebcastRoomUserSeqMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[15]
+	mi := &file_webcast_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1701,7 +1749,7 @@ func (x *WebcastRoomUserSeqMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastRoomUserSeqMessage.ProtoReflect.Descriptor instead.
 func (*WebcastRoomUserSeqMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{15}
+	return file_webcast_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *WebcastRoomUserSeqMessage) GetCommon() *Common {
@@ -1780,7 +1828,7 @@ type WebcastSocialMessage struct {
 func (x *WebcastSocialMessage) Reset() {
 	*x = WebcastSocialMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[16]
+		mi := &file_webcast_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1793,7 +1841,7 @@ func (x *WebcastSocialMessage) String() string {
 func (*WebcastSocialMessage) ProtoMessage() {}
 
 func (x *WebcastSocialMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[16]
+	mi := &file_webcast_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,7 +1854,7 @@ func (x *WebcastSocialMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastSocialMessage.ProtoReflect.Descriptor instead.
 func (*WebcastSocialMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{16}
+	return file_webcast_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WebcastSocialMessage) GetCommon() *Common {
@@ -1885,7 +1933,7 @@ type WebcastSubNotifyMessage struct {
 func (x *WebcastSubNotifyMessage) Reset() {
 	*x = WebcastSubNotifyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[17]
+		mi := &file_webcast_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1898,7 +1946,7 @@ func (x *WebcastSubNotifyMessage) String() string {
 func (*WebcastSubNotifyMessage) ProtoMessage() {}
 
 func (x *WebcastSubNotifyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[17]
+	mi := &file_webcast_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +1959,7 @@ func (x *WebcastSubNotifyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastSubNotifyMessage.ProtoReflect.Descriptor instead.
 func (*WebcastSubNotifyMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{17}
+	return file_webcast_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WebcastSubNotifyMessage) GetCommon() *Common {
@@ -1988,7 +2036,7 @@ type WebcastRankUpdateMessage struct {
 func (x *WebcastRankUpdateMessage) Reset() {
 	*x = WebcastRankUpdateMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[18]
+		mi := &file_webcast_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2001,7 +2049,7 @@ func (x *WebcastRankUpdateMessage) String() string {
 func (*WebcastRankUpdateMessage) ProtoMessage() {}
 
 func (x *WebcastRankUpdateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[18]
+	mi := &file_webcast_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,7 +2062,7 @@ func (x *WebcastRankUpdateMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastRankUpdateMessage.ProtoReflect.Descriptor instead.
 func (*WebcastRankUpdateMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{18}
+	return file_webcast_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WebcastRankUpdateMessage) GetCommon() *Common {
@@ -2101,7 +2149,7 @@ type WebcastMemberMessage struct {
 func (x *WebcastMemberMessage) Reset() {
 	*x = WebcastMemberMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[19]
+		mi := &file_webcast_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2114,7 +2162,7 @@ func (x *WebcastMemberMessage) String() string {
 func (*WebcastMemberMessage) ProtoMessage() {}
 
 func (x *WebcastMemberMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[19]
+	mi := &file_webcast_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,7 +2175,7 @@ func (x *WebcastMemberMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastMemberMessage.ProtoReflect.Descriptor instead.
 func (*WebcastMemberMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{19}
+	return file_webcast_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *WebcastMemberMessage) GetCommon() *Common {
@@ -2310,7 +2358,7 @@ type WebcastPollMessage struct {
 func (x *WebcastPollMessage) Reset() {
 	*x = WebcastPollMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[20]
+		mi := &file_webcast_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2323,7 +2371,7 @@ func (x *WebcastPollMessage) String() string {
 func (*WebcastPollMessage) ProtoMessage() {}
 
 func (x *WebcastPollMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[20]
+	mi := &file_webcast_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2384,7 @@ func (x *WebcastPollMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastPollMessage.ProtoReflect.Descriptor instead.
 func (*WebcastPollMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{20}
+	return file_webcast_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WebcastPollMessage) GetCommon() *Common {
@@ -2401,7 +2449,7 @@ type WebcastQuestionNewMessage struct {
 func (x *WebcastQuestionNewMessage) Reset() {
 	*x = WebcastQuestionNewMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[21]
+		mi := &file_webcast_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2414,7 +2462,7 @@ func (x *WebcastQuestionNewMessage) String() string {
 func (*WebcastQuestionNewMessage) ProtoMessage() {}
 
 func (x *WebcastQuestionNewMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[21]
+	mi := &file_webcast_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +2475,7 @@ func (x *WebcastQuestionNewMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastQuestionNewMessage.ProtoReflect.Descriptor instead.
 func (*WebcastQuestionNewMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{21}
+	return file_webcast_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *WebcastQuestionNewMessage) GetCommon() *Common {
@@ -2462,7 +2510,7 @@ type WebcastRankTextMessage struct {
 func (x *WebcastRankTextMessage) Reset() {
 	*x = WebcastRankTextMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[22]
+		mi := &file_webcast_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2475,7 +2523,7 @@ func (x *WebcastRankTextMessage) String() string {
 func (*WebcastRankTextMessage) ProtoMessage() {}
 
 func (x *WebcastRankTextMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[22]
+	mi := &file_webcast_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2488,7 +2536,7 @@ func (x *WebcastRankTextMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastRankTextMessage.ProtoReflect.Descriptor instead.
 func (*WebcastRankTextMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{22}
+	return file_webcast_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *WebcastRankTextMessage) GetCommon() *Common {
@@ -2554,7 +2602,7 @@ type WebcastHourlyRankMessage struct {
 func (x *WebcastHourlyRankMessage) Reset() {
 	*x = WebcastHourlyRankMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[23]
+		mi := &file_webcast_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2567,7 +2615,7 @@ func (x *WebcastHourlyRankMessage) String() string {
 func (*WebcastHourlyRankMessage) ProtoMessage() {}
 
 func (x *WebcastHourlyRankMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[23]
+	mi := &file_webcast_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,7 +2628,7 @@ func (x *WebcastHourlyRankMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastHourlyRankMessage.ProtoReflect.Descriptor instead.
 func (*WebcastHourlyRankMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{23}
+	return file_webcast_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *WebcastHourlyRankMessage) GetCommon() *Common {
@@ -2628,7 +2676,7 @@ type WebcastLinkMicArmies struct {
 func (x *WebcastLinkMicArmies) Reset() {
 	*x = WebcastLinkMicArmies{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[24]
+		mi := &file_webcast_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2641,7 +2689,7 @@ func (x *WebcastLinkMicArmies) String() string {
 func (*WebcastLinkMicArmies) ProtoMessage() {}
 
 func (x *WebcastLinkMicArmies) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[24]
+	mi := &file_webcast_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2654,7 +2702,7 @@ func (x *WebcastLinkMicArmies) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMicArmies.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicArmies) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{24}
+	return file_webcast_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *WebcastLinkMicArmies) GetCommon() *Common {
@@ -2765,7 +2813,7 @@ type WebcastLinkMicBattlePunishFinish struct {
 func (x *WebcastLinkMicBattlePunishFinish) Reset() {
 	*x = WebcastLinkMicBattlePunishFinish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[25]
+		mi := &file_webcast_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2778,7 +2826,7 @@ func (x *WebcastLinkMicBattlePunishFinish) String() string {
 func (*WebcastLinkMicBattlePunishFinish) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattlePunishFinish) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[25]
+	mi := &file_webcast_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +2839,7 @@ func (x *WebcastLinkMicBattlePunishFinish) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMicBattlePunishFinish.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattlePunishFinish) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{25}
+	return file_webcast_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WebcastLinkMicBattlePunishFinish) GetHeader() *Common {
@@ -2851,7 +2899,7 @@ type WebcastLinkmicBattleTaskMessage struct {
 func (x *WebcastLinkmicBattleTaskMessage) Reset() {
 	*x = WebcastLinkmicBattleTaskMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[26]
+		mi := &file_webcast_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2864,7 +2912,7 @@ func (x *WebcastLinkmicBattleTaskMessage) String() string {
 func (*WebcastLinkmicBattleTaskMessage) ProtoMessage() {}
 
 func (x *WebcastLinkmicBattleTaskMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[26]
+	mi := &file_webcast_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2877,7 +2925,7 @@ func (x *WebcastLinkmicBattleTaskMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkmicBattleTaskMessage.ProtoReflect.Descriptor instead.
 func (*WebcastLinkmicBattleTaskMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{26}
+	return file_webcast_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *WebcastLinkmicBattleTaskMessage) GetHeader() *Common {
@@ -2929,7 +2977,7 @@ type WebcastLinkMicBattle struct {
 func (x *WebcastLinkMicBattle) Reset() {
 	*x = WebcastLinkMicBattle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[27]
+		mi := &file_webcast_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2942,7 +2990,7 @@ func (x *WebcastLinkMicBattle) String() string {
 func (*WebcastLinkMicBattle) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[27]
+	mi := &file_webcast_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2955,7 +3003,7 @@ func (x *WebcastLinkMicBattle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMicBattle.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27}
+	return file_webcast_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *WebcastLinkMicBattle) GetCommon() *Common {
@@ -3041,7 +3089,7 @@ type WebcastLinkMicFanTicketMethod struct {
 func (x *WebcastLinkMicFanTicketMethod) Reset() {
 	*x = WebcastLinkMicFanTicketMethod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[28]
+		mi := &file_webcast_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3054,7 +3102,7 @@ func (x *WebcastLinkMicFanTicketMethod) String() string {
 func (*WebcastLinkMicFanTicketMethod) ProtoMessage() {}
 
 func (x *WebcastLinkMicFanTicketMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[28]
+	mi := &file_webcast_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3067,7 +3115,7 @@ func (x *WebcastLinkMicFanTicketMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMicFanTicketMethod.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicFanTicketMethod) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{28}
+	return file_webcast_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *WebcastLinkMicFanTicketMethod) GetCommon() *Common {
@@ -3108,7 +3156,7 @@ type WebcastLinkMicMethod struct {
 func (x *WebcastLinkMicMethod) Reset() {
 	*x = WebcastLinkMicMethod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[29]
+		mi := &file_webcast_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3121,7 +3169,7 @@ func (x *WebcastLinkMicMethod) String() string {
 func (*WebcastLinkMicMethod) ProtoMessage() {}
 
 func (x *WebcastLinkMicMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[29]
+	mi := &file_webcast_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +3182,7 @@ func (x *WebcastLinkMicMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMicMethod.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicMethod) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{29}
+	return file_webcast_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *WebcastLinkMicMethod) GetCommon() *Common {
@@ -3247,7 +3295,7 @@ type WebcastLiveIntroMessage struct {
 func (x *WebcastLiveIntroMessage) Reset() {
 	*x = WebcastLiveIntroMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[30]
+		mi := &file_webcast_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3260,7 +3308,7 @@ func (x *WebcastLiveIntroMessage) String() string {
 func (*WebcastLiveIntroMessage) ProtoMessage() {}
 
 func (x *WebcastLiveIntroMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[30]
+	mi := &file_webcast_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3273,7 +3321,7 @@ func (x *WebcastLiveIntroMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLiveIntroMessage.ProtoReflect.Descriptor instead.
 func (*WebcastLiveIntroMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{30}
+	return file_webcast_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *WebcastLiveIntroMessage) GetCommon() *Common {
@@ -3348,7 +3396,7 @@ type WebcastUnauthorizedMemberMessage struct {
 func (x *WebcastUnauthorizedMemberMessage) Reset() {
 	*x = WebcastUnauthorizedMemberMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[31]
+		mi := &file_webcast_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3361,7 +3409,7 @@ func (x *WebcastUnauthorizedMemberMessage) String() string {
 func (*WebcastUnauthorizedMemberMessage) ProtoMessage() {}
 
 func (x *WebcastUnauthorizedMemberMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[31]
+	mi := &file_webcast_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3374,7 +3422,7 @@ func (x *WebcastUnauthorizedMemberMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastUnauthorizedMemberMessage.ProtoReflect.Descriptor instead.
 func (*WebcastUnauthorizedMemberMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{31}
+	return file_webcast_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *WebcastUnauthorizedMemberMessage) GetCommon() *Common {
@@ -3429,7 +3477,7 @@ type WebcastMsgDetectMessage struct {
 func (x *WebcastMsgDetectMessage) Reset() {
 	*x = WebcastMsgDetectMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[32]
+		mi := &file_webcast_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3442,7 +3490,7 @@ func (x *WebcastMsgDetectMessage) String() string {
 func (*WebcastMsgDetectMessage) ProtoMessage() {}
 
 func (x *WebcastMsgDetectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[32]
+	mi := &file_webcast_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3455,7 +3503,7 @@ func (x *WebcastMsgDetectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastMsgDetectMessage.ProtoReflect.Descriptor instead.
 func (*WebcastMsgDetectMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{32}
+	return file_webcast_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *WebcastMsgDetectMessage) GetCommon() *Common {
@@ -3516,7 +3564,7 @@ type WebcastOecLiveShoppingMessage struct {
 func (x *WebcastOecLiveShoppingMessage) Reset() {
 	*x = WebcastOecLiveShoppingMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[33]
+		mi := &file_webcast_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3529,7 +3577,7 @@ func (x *WebcastOecLiveShoppingMessage) String() string {
 func (*WebcastOecLiveShoppingMessage) ProtoMessage() {}
 
 func (x *WebcastOecLiveShoppingMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[33]
+	mi := &file_webcast_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3542,7 +3590,7 @@ func (x *WebcastOecLiveShoppingMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastOecLiveShoppingMessage.ProtoReflect.Descriptor instead.
 func (*WebcastOecLiveShoppingMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{33}
+	return file_webcast_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *WebcastOecLiveShoppingMessage) GetCommon() *Common {
@@ -3596,7 +3644,7 @@ type WebcastRoomPinMessage struct {
 func (x *WebcastRoomPinMessage) Reset() {
 	*x = WebcastRoomPinMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[34]
+		mi := &file_webcast_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3609,7 +3657,7 @@ func (x *WebcastRoomPinMessage) String() string {
 func (*WebcastRoomPinMessage) ProtoMessage() {}
 
 func (x *WebcastRoomPinMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[34]
+	mi := &file_webcast_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,7 +3670,7 @@ func (x *WebcastRoomPinMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastRoomPinMessage.ProtoReflect.Descriptor instead.
 func (*WebcastRoomPinMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{34}
+	return file_webcast_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *WebcastRoomPinMessage) GetCommon() *Common {
@@ -3667,7 +3715,7 @@ type WebcastLiveGameIntroMessage struct {
 func (x *WebcastLiveGameIntroMessage) Reset() {
 	*x = WebcastLiveGameIntroMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[35]
+		mi := &file_webcast_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3680,7 +3728,7 @@ func (x *WebcastLiveGameIntroMessage) String() string {
 func (*WebcastLiveGameIntroMessage) ProtoMessage() {}
 
 func (x *WebcastLiveGameIntroMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[35]
+	mi := &file_webcast_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3693,7 +3741,7 @@ func (x *WebcastLiveGameIntroMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLiveGameIntroMessage.ProtoReflect.Descriptor instead.
 func (*WebcastLiveGameIntroMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{35}
+	return file_webcast_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *WebcastLiveGameIntroMessage) GetCommon() *Common {
@@ -3723,7 +3771,7 @@ type WebcastSystemMessage struct {
 func (x *WebcastSystemMessage) Reset() {
 	*x = WebcastSystemMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[36]
+		mi := &file_webcast_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3736,7 +3784,7 @@ func (x *WebcastSystemMessage) String() string {
 func (*WebcastSystemMessage) ProtoMessage() {}
 
 func (x *WebcastSystemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[36]
+	mi := &file_webcast_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,7 +3797,7 @@ func (x *WebcastSystemMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastSystemMessage.ProtoReflect.Descriptor instead.
 func (*WebcastSystemMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{36}
+	return file_webcast_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *WebcastSystemMessage) GetCommon() *Common {
@@ -3805,7 +3853,7 @@ type WebcastLinkMessage struct {
 func (x *WebcastLinkMessage) Reset() {
 	*x = WebcastLinkMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[37]
+		mi := &file_webcast_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3818,7 +3866,7 @@ func (x *WebcastLinkMessage) String() string {
 func (*WebcastLinkMessage) ProtoMessage() {}
 
 func (x *WebcastLinkMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[37]
+	mi := &file_webcast_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +3879,7 @@ func (x *WebcastLinkMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMessage.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{37}
+	return file_webcast_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *WebcastLinkMessage) GetCommon() *Common {
@@ -4063,7 +4111,7 @@ type WebcastLinkLayerMessage struct {
 func (x *WebcastLinkLayerMessage) Reset() {
 	*x = WebcastLinkLayerMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[38]
+		mi := &file_webcast_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4076,7 +4124,7 @@ func (x *WebcastLinkLayerMessage) String() string {
 func (*WebcastLinkLayerMessage) ProtoMessage() {}
 
 func (x *WebcastLinkLayerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[38]
+	mi := &file_webcast_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4089,7 +4137,7 @@ func (x *WebcastLinkLayerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkLayerMessage.ProtoReflect.Descriptor instead.
 func (*WebcastLinkLayerMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{38}
+	return file_webcast_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *WebcastLinkLayerMessage) GetCommon() *Common {
@@ -4262,7 +4310,7 @@ type RoomVerifyMessage struct {
 func (x *RoomVerifyMessage) Reset() {
 	*x = RoomVerifyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[39]
+		mi := &file_webcast_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4275,7 +4323,7 @@ func (x *RoomVerifyMessage) String() string {
 func (*RoomVerifyMessage) ProtoMessage() {}
 
 func (x *RoomVerifyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[39]
+	mi := &file_webcast_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4288,7 +4336,7 @@ func (x *RoomVerifyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomVerifyMessage.ProtoReflect.Descriptor instead.
 func (*RoomVerifyMessage) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{39}
+	return file_webcast_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *RoomVerifyMessage) GetCommon() *Common {
@@ -4343,7 +4391,7 @@ type WebcastResponse_Message struct {
 func (x *WebcastResponse_Message) Reset() {
 	*x = WebcastResponse_Message{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[42]
+		mi := &file_webcast_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4356,7 +4404,7 @@ func (x *WebcastResponse_Message) String() string {
 func (*WebcastResponse_Message) ProtoMessage() {}
 
 func (x *WebcastResponse_Message) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[42]
+	mi := &file_webcast_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4426,7 +4474,7 @@ type WebcastGiftMessage_UserGiftReciever struct {
 func (x *WebcastGiftMessage_UserGiftReciever) Reset() {
 	*x = WebcastGiftMessage_UserGiftReciever{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[43]
+		mi := &file_webcast_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4439,7 +4487,7 @@ func (x *WebcastGiftMessage_UserGiftReciever) String() string {
 func (*WebcastGiftMessage_UserGiftReciever) ProtoMessage() {}
 
 func (x *WebcastGiftMessage_UserGiftReciever) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[43]
+	mi := &file_webcast_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4452,7 +4500,7 @@ func (x *WebcastGiftMessage_UserGiftReciever) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use WebcastGiftMessage_UserGiftReciever.ProtoReflect.Descriptor instead.
 func (*WebcastGiftMessage_UserGiftReciever) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{2, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *WebcastGiftMessage_UserGiftReciever) GetUserId() int64 {
@@ -4482,7 +4530,7 @@ type WebcastGiftMessage_GiftIMPriority struct {
 func (x *WebcastGiftMessage_GiftIMPriority) Reset() {
 	*x = WebcastGiftMessage_GiftIMPriority{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[44]
+		mi := &file_webcast_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4495,7 +4543,7 @@ func (x *WebcastGiftMessage_GiftIMPriority) String() string {
 func (*WebcastGiftMessage_GiftIMPriority) ProtoMessage() {}
 
 func (x *WebcastGiftMessage_GiftIMPriority) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[44]
+	mi := &file_webcast_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4508,7 +4556,7 @@ func (x *WebcastGiftMessage_GiftIMPriority) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebcastGiftMessage_GiftIMPriority.ProtoReflect.Descriptor instead.
 func (*WebcastGiftMessage_GiftIMPriority) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{2, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *WebcastGiftMessage_GiftIMPriority) GetQueueSizesList() []int64 {
@@ -4544,7 +4592,7 @@ type WebcastGiftMessage_PublicAreaCommon struct {
 func (x *WebcastGiftMessage_PublicAreaCommon) Reset() {
 	*x = WebcastGiftMessage_PublicAreaCommon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[45]
+		mi := &file_webcast_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4557,7 +4605,7 @@ func (x *WebcastGiftMessage_PublicAreaCommon) String() string {
 func (*WebcastGiftMessage_PublicAreaCommon) ProtoMessage() {}
 
 func (x *WebcastGiftMessage_PublicAreaCommon) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[45]
+	mi := &file_webcast_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4570,7 +4618,7 @@ func (x *WebcastGiftMessage_PublicAreaCommon) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use WebcastGiftMessage_PublicAreaCommon.ProtoReflect.Descriptor instead.
 func (*WebcastGiftMessage_PublicAreaCommon) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{2, 2}
+	return file_webcast_proto_rawDescGZIP(), []int{3, 2}
 }
 
 func (x *WebcastGiftMessage_PublicAreaCommon) GetUserLabel() *Image {
@@ -4601,7 +4649,7 @@ type WebcastBarrageMessage_BarrageTypeUserGradeParam struct {
 func (x *WebcastBarrageMessage_BarrageTypeUserGradeParam) Reset() {
 	*x = WebcastBarrageMessage_BarrageTypeUserGradeParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[46]
+		mi := &file_webcast_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4614,7 +4662,7 @@ func (x *WebcastBarrageMessage_BarrageTypeUserGradeParam) String() string {
 func (*WebcastBarrageMessage_BarrageTypeUserGradeParam) ProtoMessage() {}
 
 func (x *WebcastBarrageMessage_BarrageTypeUserGradeParam) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[46]
+	mi := &file_webcast_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4627,7 +4675,7 @@ func (x *WebcastBarrageMessage_BarrageTypeUserGradeParam) ProtoReflect() protore
 
 // Deprecated: Use WebcastBarrageMessage_BarrageTypeUserGradeParam.ProtoReflect.Descriptor instead.
 func (*WebcastBarrageMessage_BarrageTypeUserGradeParam) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{5, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *WebcastBarrageMessage_BarrageTypeUserGradeParam) GetCurrentGrade() int32 {
@@ -4671,7 +4719,7 @@ type WebcastBarrageMessage_BarrageTypeFansLevelParam struct {
 func (x *WebcastBarrageMessage_BarrageTypeFansLevelParam) Reset() {
 	*x = WebcastBarrageMessage_BarrageTypeFansLevelParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[47]
+		mi := &file_webcast_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4684,7 +4732,7 @@ func (x *WebcastBarrageMessage_BarrageTypeFansLevelParam) String() string {
 func (*WebcastBarrageMessage_BarrageTypeFansLevelParam) ProtoMessage() {}
 
 func (x *WebcastBarrageMessage_BarrageTypeFansLevelParam) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[47]
+	mi := &file_webcast_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4697,7 +4745,7 @@ func (x *WebcastBarrageMessage_BarrageTypeFansLevelParam) ProtoReflect() protore
 
 // Deprecated: Use WebcastBarrageMessage_BarrageTypeFansLevelParam.ProtoReflect.Descriptor instead.
 func (*WebcastBarrageMessage_BarrageTypeFansLevelParam) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{5, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *WebcastBarrageMessage_BarrageTypeFansLevelParam) GetCurrentGrade() int32 {
@@ -4733,7 +4781,7 @@ type WebcastBarrageMessage_BarrageTypeSubscribeGiftParam struct {
 func (x *WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) Reset() {
 	*x = WebcastBarrageMessage_BarrageTypeSubscribeGiftParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[48]
+		mi := &file_webcast_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4746,7 +4794,7 @@ func (x *WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) String() string {
 func (*WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) ProtoMessage() {}
 
 func (x *WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[48]
+	mi := &file_webcast_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4759,7 +4807,7 @@ func (x *WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) ProtoReflect() pro
 
 // Deprecated: Use WebcastBarrageMessage_BarrageTypeSubscribeGiftParam.ProtoReflect.Descriptor instead.
 func (*WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{5, 2}
+	return file_webcast_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *WebcastBarrageMessage_BarrageTypeSubscribeGiftParam) GetGiftSubCount() int64 {
@@ -4787,7 +4835,7 @@ type WebcastBarrageMessage_BarrageEvent struct {
 func (x *WebcastBarrageMessage_BarrageEvent) Reset() {
 	*x = WebcastBarrageMessage_BarrageEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[49]
+		mi := &file_webcast_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4800,7 +4848,7 @@ func (x *WebcastBarrageMessage_BarrageEvent) String() string {
 func (*WebcastBarrageMessage_BarrageEvent) ProtoMessage() {}
 
 func (x *WebcastBarrageMessage_BarrageEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[49]
+	mi := &file_webcast_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4813,7 +4861,7 @@ func (x *WebcastBarrageMessage_BarrageEvent) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebcastBarrageMessage_BarrageEvent.ProtoReflect.Descriptor instead.
 func (*WebcastBarrageMessage_BarrageEvent) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{5, 3}
+	return file_webcast_proto_rawDescGZIP(), []int{6, 3}
 }
 
 func (x *WebcastBarrageMessage_BarrageEvent) GetEventName() string {
@@ -4835,7 +4883,7 @@ type WebcastCaptionMessage_CaptionData struct {
 func (x *WebcastCaptionMessage_CaptionData) Reset() {
 	*x = WebcastCaptionMessage_CaptionData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[50]
+		mi := &file_webcast_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4848,7 +4896,7 @@ func (x *WebcastCaptionMessage_CaptionData) String() string {
 func (*WebcastCaptionMessage_CaptionData) ProtoMessage() {}
 
 func (x *WebcastCaptionMessage_CaptionData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[50]
+	mi := &file_webcast_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +4909,7 @@ func (x *WebcastCaptionMessage_CaptionData) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebcastCaptionMessage_CaptionData.ProtoReflect.Descriptor instead.
 func (*WebcastCaptionMessage_CaptionData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{6, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *WebcastCaptionMessage_CaptionData) GetLanguage() string {
@@ -4892,7 +4940,7 @@ type WebcastChatMessage_EmoteWithIndex struct {
 func (x *WebcastChatMessage_EmoteWithIndex) Reset() {
 	*x = WebcastChatMessage_EmoteWithIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[52]
+		mi := &file_webcast_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4905,7 +4953,7 @@ func (x *WebcastChatMessage_EmoteWithIndex) String() string {
 func (*WebcastChatMessage_EmoteWithIndex) ProtoMessage() {}
 
 func (x *WebcastChatMessage_EmoteWithIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[52]
+	mi := &file_webcast_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4918,7 +4966,7 @@ func (x *WebcastChatMessage_EmoteWithIndex) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebcastChatMessage_EmoteWithIndex.ProtoReflect.Descriptor instead.
 func (*WebcastChatMessage_EmoteWithIndex) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{7, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{8, 1}
 }
 
 func (x *WebcastChatMessage_EmoteWithIndex) GetIndex() int64 {
@@ -4955,7 +5003,7 @@ type WebcastControlMessage_Extra struct {
 func (x *WebcastControlMessage_Extra) Reset() {
 	*x = WebcastControlMessage_Extra{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[53]
+		mi := &file_webcast_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4968,7 +5016,7 @@ func (x *WebcastControlMessage_Extra) String() string {
 func (*WebcastControlMessage_Extra) ProtoMessage() {}
 
 func (x *WebcastControlMessage_Extra) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[53]
+	mi := &file_webcast_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4981,7 +5029,7 @@ func (x *WebcastControlMessage_Extra) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastControlMessage_Extra.ProtoReflect.Descriptor instead.
 func (*WebcastControlMessage_Extra) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{8, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *WebcastControlMessage_Extra) GetBanInfoUrl() string {
@@ -5065,7 +5113,7 @@ type WebcastEnvelopeMessage_EnvelopeInfo struct {
 func (x *WebcastEnvelopeMessage_EnvelopeInfo) Reset() {
 	*x = WebcastEnvelopeMessage_EnvelopeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[54]
+		mi := &file_webcast_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5078,7 +5126,7 @@ func (x *WebcastEnvelopeMessage_EnvelopeInfo) String() string {
 func (*WebcastEnvelopeMessage_EnvelopeInfo) ProtoMessage() {}
 
 func (x *WebcastEnvelopeMessage_EnvelopeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[54]
+	mi := &file_webcast_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5091,7 +5139,7 @@ func (x *WebcastEnvelopeMessage_EnvelopeInfo) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use WebcastEnvelopeMessage_EnvelopeInfo.ProtoReflect.Descriptor instead.
 func (*WebcastEnvelopeMessage_EnvelopeInfo) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{10, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *WebcastEnvelopeMessage_EnvelopeInfo) GetEnvelopeId() string {
@@ -5200,7 +5248,7 @@ type WebcastRoomUserSeqMessage_Contributor struct {
 func (x *WebcastRoomUserSeqMessage_Contributor) Reset() {
 	*x = WebcastRoomUserSeqMessage_Contributor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[55]
+		mi := &file_webcast_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5213,7 +5261,7 @@ func (x *WebcastRoomUserSeqMessage_Contributor) String() string {
 func (*WebcastRoomUserSeqMessage_Contributor) ProtoMessage() {}
 
 func (x *WebcastRoomUserSeqMessage_Contributor) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[55]
+	mi := &file_webcast_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5226,7 +5274,7 @@ func (x *WebcastRoomUserSeqMessage_Contributor) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use WebcastRoomUserSeqMessage_Contributor.ProtoReflect.Descriptor instead.
 func (*WebcastRoomUserSeqMessage_Contributor) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{15, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *WebcastRoomUserSeqMessage_Contributor) GetScore() int32 {
@@ -5271,7 +5319,7 @@ type WebcastRankUpdateMessage_RankTabInfo struct {
 func (x *WebcastRankUpdateMessage_RankTabInfo) Reset() {
 	*x = WebcastRankUpdateMessage_RankTabInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[56]
+		mi := &file_webcast_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5284,7 +5332,7 @@ func (x *WebcastRankUpdateMessage_RankTabInfo) String() string {
 func (*WebcastRankUpdateMessage_RankTabInfo) ProtoMessage() {}
 
 func (x *WebcastRankUpdateMessage_RankTabInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[56]
+	mi := &file_webcast_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5297,7 +5345,7 @@ func (x *WebcastRankUpdateMessage_RankTabInfo) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use WebcastRankUpdateMessage_RankTabInfo.ProtoReflect.Descriptor instead.
 func (*WebcastRankUpdateMessage_RankTabInfo) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{18, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *WebcastRankUpdateMessage_RankTabInfo) GetRankType() int64 {
@@ -5349,7 +5397,7 @@ type WebcastRankUpdateMessage_RankUpdate struct {
 func (x *WebcastRankUpdateMessage_RankUpdate) Reset() {
 	*x = WebcastRankUpdateMessage_RankUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[57]
+		mi := &file_webcast_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5362,7 +5410,7 @@ func (x *WebcastRankUpdateMessage_RankUpdate) String() string {
 func (*WebcastRankUpdateMessage_RankUpdate) ProtoMessage() {}
 
 func (x *WebcastRankUpdateMessage_RankUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[57]
+	mi := &file_webcast_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5375,7 +5423,7 @@ func (x *WebcastRankUpdateMessage_RankUpdate) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use WebcastRankUpdateMessage_RankUpdate.ProtoReflect.Descriptor instead.
 func (*WebcastRankUpdateMessage_RankUpdate) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{18, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{19, 1}
 }
 
 func (x *WebcastRankUpdateMessage_RankUpdate) GetRankType() int64 {
@@ -5463,7 +5511,7 @@ type WebcastMemberMessage_EffectConfig struct {
 func (x *WebcastMemberMessage_EffectConfig) Reset() {
 	*x = WebcastMemberMessage_EffectConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[58]
+		mi := &file_webcast_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5476,7 +5524,7 @@ func (x *WebcastMemberMessage_EffectConfig) String() string {
 func (*WebcastMemberMessage_EffectConfig) ProtoMessage() {}
 
 func (x *WebcastMemberMessage_EffectConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[58]
+	mi := &file_webcast_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5489,7 +5537,7 @@ func (x *WebcastMemberMessage_EffectConfig) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebcastMemberMessage_EffectConfig.ProtoReflect.Descriptor instead.
 func (*WebcastMemberMessage_EffectConfig) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{19, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *WebcastMemberMessage_EffectConfig) GetType() int64 {
@@ -5570,7 +5618,7 @@ type WebcastQuestionNewMessage_QuestionDetails struct {
 func (x *WebcastQuestionNewMessage_QuestionDetails) Reset() {
 	*x = WebcastQuestionNewMessage_QuestionDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[59]
+		mi := &file_webcast_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5583,7 +5631,7 @@ func (x *WebcastQuestionNewMessage_QuestionDetails) String() string {
 func (*WebcastQuestionNewMessage_QuestionDetails) ProtoMessage() {}
 
 func (x *WebcastQuestionNewMessage_QuestionDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[59]
+	mi := &file_webcast_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5596,7 +5644,7 @@ func (x *WebcastQuestionNewMessage_QuestionDetails) ProtoReflect() protoreflect.
 
 // Deprecated: Use WebcastQuestionNewMessage_QuestionDetails.ProtoReflect.Descriptor instead.
 func (*WebcastQuestionNewMessage_QuestionDetails) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{21, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *WebcastQuestionNewMessage_QuestionDetails) GetId() uint64 {
@@ -5651,7 +5699,7 @@ type WebcastHourlyRankMessage_RankContainer struct {
 func (x *WebcastHourlyRankMessage_RankContainer) Reset() {
 	*x = WebcastHourlyRankMessage_RankContainer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[60]
+		mi := &file_webcast_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5664,7 +5712,7 @@ func (x *WebcastHourlyRankMessage_RankContainer) String() string {
 func (*WebcastHourlyRankMessage_RankContainer) ProtoMessage() {}
 
 func (x *WebcastHourlyRankMessage_RankContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[60]
+	mi := &file_webcast_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5677,7 +5725,7 @@ func (x *WebcastHourlyRankMessage_RankContainer) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use WebcastHourlyRankMessage_RankContainer.ProtoReflect.Descriptor instead.
 func (*WebcastHourlyRankMessage_RankContainer) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{23, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *WebcastHourlyRankMessage_RankContainer) GetData1() uint32 {
@@ -5742,7 +5790,7 @@ type WebcastHourlyRankMessage_RankContainer_RankingData struct {
 func (x *WebcastHourlyRankMessage_RankContainer_RankingData) Reset() {
 	*x = WebcastHourlyRankMessage_RankContainer_RankingData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[61]
+		mi := &file_webcast_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5755,7 +5803,7 @@ func (x *WebcastHourlyRankMessage_RankContainer_RankingData) String() string {
 func (*WebcastHourlyRankMessage_RankContainer_RankingData) ProtoMessage() {}
 
 func (x *WebcastHourlyRankMessage_RankContainer_RankingData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[61]
+	mi := &file_webcast_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5768,7 +5816,7 @@ func (x *WebcastHourlyRankMessage_RankContainer_RankingData) ProtoReflect() prot
 
 // Deprecated: Use WebcastHourlyRankMessage_RankContainer_RankingData.ProtoReflect.Descriptor instead.
 func (*WebcastHourlyRankMessage_RankContainer_RankingData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{23, 0, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{24, 0, 0}
 }
 
 func (x *WebcastHourlyRankMessage_RankContainer_RankingData) GetData1() uint32 {
@@ -5808,7 +5856,7 @@ type WebcastHourlyRankMessage_RankContainer_RankingData2 struct {
 func (x *WebcastHourlyRankMessage_RankContainer_RankingData2) Reset() {
 	*x = WebcastHourlyRankMessage_RankContainer_RankingData2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[62]
+		mi := &file_webcast_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5821,7 +5869,7 @@ func (x *WebcastHourlyRankMessage_RankContainer_RankingData2) String() string {
 func (*WebcastHourlyRankMessage_RankContainer_RankingData2) ProtoMessage() {}
 
 func (x *WebcastHourlyRankMessage_RankContainer_RankingData2) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[62]
+	mi := &file_webcast_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5834,7 +5882,7 @@ func (x *WebcastHourlyRankMessage_RankContainer_RankingData2) ProtoReflect() pro
 
 // Deprecated: Use WebcastHourlyRankMessage_RankContainer_RankingData2.ProtoReflect.Descriptor instead.
 func (*WebcastHourlyRankMessage_RankContainer_RankingData2) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{23, 0, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{24, 0, 1}
 }
 
 func (x *WebcastHourlyRankMessage_RankContainer_RankingData2) GetData1() uint32 {
@@ -5896,7 +5944,7 @@ type WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData struct {
 func (x *WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) Reset() {
 	*x = WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[63]
+		mi := &file_webcast_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5909,7 +5957,7 @@ func (x *WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) String(
 func (*WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[63]
+	mi := &file_webcast_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5922,7 +5970,7 @@ func (x *WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) ProtoRe
 
 // Deprecated: Use WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{25, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData) GetId2() uint64 {
@@ -5985,7 +6033,7 @@ type WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData struct {
 func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) Reset() {
 	*x = WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[64]
+		mi := &file_webcast_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5998,7 +6046,7 @@ func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) String() string
 func (*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) ProtoMessage() {}
 
 func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[64]
+	mi := &file_webcast_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6011,7 +6059,7 @@ func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) ProtoReflect() p
 
 // Deprecated: Use WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData.ProtoReflect.Descriptor instead.
 func (*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{26, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData) GetData1() *WebcastLinkmicBattleTaskMessage_BattleTaskData {
@@ -6032,7 +6080,7 @@ type WebcastLinkmicBattleTaskMessage_BattleTaskData struct {
 func (x *WebcastLinkmicBattleTaskMessage_BattleTaskData) Reset() {
 	*x = WebcastLinkmicBattleTaskMessage_BattleTaskData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[65]
+		mi := &file_webcast_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6045,7 +6093,7 @@ func (x *WebcastLinkmicBattleTaskMessage_BattleTaskData) String() string {
 func (*WebcastLinkmicBattleTaskMessage_BattleTaskData) ProtoMessage() {}
 
 func (x *WebcastLinkmicBattleTaskMessage_BattleTaskData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[65]
+	mi := &file_webcast_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6058,7 +6106,7 @@ func (x *WebcastLinkmicBattleTaskMessage_BattleTaskData) ProtoReflect() protoref
 
 // Deprecated: Use WebcastLinkmicBattleTaskMessage_BattleTaskData.ProtoReflect.Descriptor instead.
 func (*WebcastLinkmicBattleTaskMessage_BattleTaskData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{26, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{27, 1}
 }
 
 func (x *WebcastLinkmicBattleTaskMessage_BattleTaskData) GetData1() uint32 {
@@ -6080,7 +6128,7 @@ type WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2 struct {
 func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) Reset() {
 	*x = WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[66]
+		mi := &file_webcast_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6093,7 +6141,7 @@ func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) String() string
 func (*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) ProtoMessage() {}
 
 func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[66]
+	mi := &file_webcast_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6106,7 +6154,7 @@ func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) ProtoReflect()
 
 // Deprecated: Use WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2.ProtoReflect.Descriptor instead.
 func (*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{26, 2}
+	return file_webcast_proto_rawDescGZIP(), []int{27, 2}
 }
 
 func (x *WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2) GetData1() uint32 {
@@ -6137,7 +6185,7 @@ type WebcastLinkMicBattle_Host2V2Data struct {
 func (x *WebcastLinkMicBattle_Host2V2Data) Reset() {
 	*x = WebcastLinkMicBattle_Host2V2Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[67]
+		mi := &file_webcast_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6150,7 +6198,7 @@ func (x *WebcastLinkMicBattle_Host2V2Data) String() string {
 func (*WebcastLinkMicBattle_Host2V2Data) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_Host2V2Data) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[67]
+	mi := &file_webcast_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6163,7 +6211,7 @@ func (x *WebcastLinkMicBattle_Host2V2Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastLinkMicBattle_Host2V2Data.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_Host2V2Data) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *WebcastLinkMicBattle_Host2V2Data) GetTeamNumber() uint32 {
@@ -6211,7 +6259,7 @@ type WebcastLinkMicBattle_LinkMicBattleConfig struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleConfig) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[68]
+		mi := &file_webcast_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6224,7 +6272,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleConfig) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleConfig) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[68]
+	mi := &file_webcast_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6237,7 +6285,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleConfig) ProtoReflect() protoreflect.M
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleConfig.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleConfig) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 1}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleConfig) GetId1() uint64 {
@@ -6301,7 +6349,7 @@ type WebcastLinkMicBattle_LinkMicBattleTeamData struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleTeamData) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleTeamData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[69]
+		mi := &file_webcast_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6314,7 +6362,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTeamData) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleTeamData) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTeamData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[69]
+	mi := &file_webcast_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6327,7 +6375,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTeamData) ProtoReflect() protoreflect
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleTeamData.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleTeamData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 2}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 2}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTeamData) GetTeamId() uint64 {
@@ -6359,7 +6407,7 @@ type WebcastLinkMicBattle_LinkMicBattleData struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleData) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[70]
+		mi := &file_webcast_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6372,7 +6420,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleData) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleData) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[70]
+	mi := &file_webcast_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6385,7 +6433,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleData) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleData.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 3}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 3}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleData) GetId() uint64 {
@@ -6435,7 +6483,7 @@ type WebcastLinkMicBattle_LinkMicBattleDetails struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleDetails) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[71]
+		mi := &file_webcast_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6448,7 +6496,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleDetails) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleDetails) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[71]
+	mi := &file_webcast_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6461,7 +6509,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleDetails) ProtoReflect() protoreflect.
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleDetails.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleDetails) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 4}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 4}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleDetails) GetId() uint64 {
@@ -6490,7 +6538,7 @@ type WebcastLinkMicBattle_LinkMicBattleTopViewers struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleTopViewers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[72]
+		mi := &file_webcast_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6503,7 +6551,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleTopViewers) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[72]
+	mi := &file_webcast_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6516,7 +6564,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers) ProtoReflect() protorefle
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleTopViewers.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleTopViewers) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 5}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 5}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers) GetId() uint64 {
@@ -6545,7 +6593,7 @@ type WebcastLinkMicBattle_LinkMicBattleHost struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleHost) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleHost{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[73]
+		mi := &file_webcast_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6558,7 +6606,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleHost) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleHost) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleHost) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[73]
+	mi := &file_webcast_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6571,7 +6619,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleHost) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleHost.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleHost) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 6}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 6}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleHost) GetId() uint64 {
@@ -6601,7 +6649,7 @@ type WebcastLinkMicBattle_Host2V2Data_HostData struct {
 func (x *WebcastLinkMicBattle_Host2V2Data_HostData) Reset() {
 	*x = WebcastLinkMicBattle_Host2V2Data_HostData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[74]
+		mi := &file_webcast_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6614,7 +6662,7 @@ func (x *WebcastLinkMicBattle_Host2V2Data_HostData) String() string {
 func (*WebcastLinkMicBattle_Host2V2Data_HostData) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_Host2V2Data_HostData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[74]
+	mi := &file_webcast_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6627,7 +6675,7 @@ func (x *WebcastLinkMicBattle_Host2V2Data_HostData) ProtoReflect() protoreflect.
 
 // Deprecated: Use WebcastLinkMicBattle_Host2V2Data_HostData.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_Host2V2Data_HostData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 0, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 0, 0}
 }
 
 func (x *WebcastLinkMicBattle_Host2V2Data_HostData) GetHostId() uint64 {
@@ -6664,7 +6712,7 @@ type WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary struc
 func (x *WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[75]
+		mi := &file_webcast_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6677,7 +6725,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary)
 func (*WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[75]
+	mi := &file_webcast_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6690,7 +6738,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary)
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 4, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 4, 0}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary) GetId() uint64 {
@@ -6727,7 +6775,7 @@ type WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[76]
+		mi := &file_webcast_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6740,7 +6788,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) String() s
 func (*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[76]
+	mi := &file_webcast_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6753,7 +6801,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) ProtoRefle
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 5, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 5, 0}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup) GetViewer() []*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer {
@@ -6792,7 +6840,7 @@ type WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer struc
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[77]
+		mi := &file_webcast_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6805,7 +6853,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer)
 func (*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[77]
+	mi := &file_webcast_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6818,7 +6866,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer)
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 5, 0, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 5, 0, 0}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer) GetId() uint64 {
@@ -6869,7 +6917,7 @@ type WebcastLinkMicBattle_LinkMicBattleHost_HostGroup struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleHost_HostGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[78]
+		mi := &file_webcast_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6882,7 +6930,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) String() string {
 func (*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[78]
+	mi := &file_webcast_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6895,7 +6943,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) ProtoReflect() protor
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleHost_HostGroup.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 6, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 6, 0}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup) GetHost() []*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host {
@@ -6933,7 +6981,7 @@ type WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host struct {
 func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) Reset() {
 	*x = WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[79]
+		mi := &file_webcast_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6946,7 +6994,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) String() string
 func (*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) ProtoMessage() {}
 
 func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[79]
+	mi := &file_webcast_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6959,7 +7007,7 @@ func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) ProtoReflect() p
 
 // Deprecated: Use WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host.ProtoReflect.Descriptor instead.
 func (*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{27, 6, 0, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{28, 6, 0, 0}
 }
 
 func (x *WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host) GetId() uint64 {
@@ -7003,7 +7051,7 @@ type WebcastMsgDetectMessage_TimeInfo struct {
 func (x *WebcastMsgDetectMessage_TimeInfo) Reset() {
 	*x = WebcastMsgDetectMessage_TimeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[80]
+		mi := &file_webcast_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7016,7 +7064,7 @@ func (x *WebcastMsgDetectMessage_TimeInfo) String() string {
 func (*WebcastMsgDetectMessage_TimeInfo) ProtoMessage() {}
 
 func (x *WebcastMsgDetectMessage_TimeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[80]
+	mi := &file_webcast_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7029,7 +7077,7 @@ func (x *WebcastMsgDetectMessage_TimeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebcastMsgDetectMessage_TimeInfo.ProtoReflect.Descriptor instead.
 func (*WebcastMsgDetectMessage_TimeInfo) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{32, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{33, 0}
 }
 
 func (x *WebcastMsgDetectMessage_TimeInfo) GetClientStartMs() int64 {
@@ -7068,7 +7116,7 @@ type WebcastMsgDetectMessage_TriggerCondition struct {
 func (x *WebcastMsgDetectMessage_TriggerCondition) Reset() {
 	*x = WebcastMsgDetectMessage_TriggerCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[81]
+		mi := &file_webcast_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7081,7 +7129,7 @@ func (x *WebcastMsgDetectMessage_TriggerCondition) String() string {
 func (*WebcastMsgDetectMessage_TriggerCondition) ProtoMessage() {}
 
 func (x *WebcastMsgDetectMessage_TriggerCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[81]
+	mi := &file_webcast_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7094,7 +7142,7 @@ func (x *WebcastMsgDetectMessage_TriggerCondition) ProtoReflect() protoreflect.M
 
 // Deprecated: Use WebcastMsgDetectMessage_TriggerCondition.ProtoReflect.Descriptor instead.
 func (*WebcastMsgDetectMessage_TriggerCondition) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{32, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{33, 1}
 }
 
 func (x *WebcastMsgDetectMessage_TriggerCondition) GetUplinkDetectHttp() bool {
@@ -7152,7 +7200,7 @@ type WebcastOecLiveShoppingMessage_LiveShoppingData struct {
 func (x *WebcastOecLiveShoppingMessage_LiveShoppingData) Reset() {
 	*x = WebcastOecLiveShoppingMessage_LiveShoppingData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[82]
+		mi := &file_webcast_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7165,7 +7213,7 @@ func (x *WebcastOecLiveShoppingMessage_LiveShoppingData) String() string {
 func (*WebcastOecLiveShoppingMessage_LiveShoppingData) ProtoMessage() {}
 
 func (x *WebcastOecLiveShoppingMessage_LiveShoppingData) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[82]
+	mi := &file_webcast_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7178,7 +7226,7 @@ func (x *WebcastOecLiveShoppingMessage_LiveShoppingData) ProtoReflect() protoref
 
 // Deprecated: Use WebcastOecLiveShoppingMessage_LiveShoppingData.ProtoReflect.Descriptor instead.
 func (*WebcastOecLiveShoppingMessage_LiveShoppingData) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{33, 0}
+	return file_webcast_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *WebcastOecLiveShoppingMessage_LiveShoppingData) GetTitle() string {
@@ -7266,7 +7314,7 @@ type WebcastOecLiveShoppingMessage_LiveShoppingDetails struct {
 func (x *WebcastOecLiveShoppingMessage_LiveShoppingDetails) Reset() {
 	*x = WebcastOecLiveShoppingMessage_LiveShoppingDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_webcast_proto_msgTypes[83]
+		mi := &file_webcast_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7279,7 +7327,7 @@ func (x *WebcastOecLiveShoppingMessage_LiveShoppingDetails) String() string {
 func (*WebcastOecLiveShoppingMessage_LiveShoppingDetails) ProtoMessage() {}
 
 func (x *WebcastOecLiveShoppingMessage_LiveShoppingDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_webcast_proto_msgTypes[83]
+	mi := &file_webcast_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7292,7 +7340,7 @@ func (x *WebcastOecLiveShoppingMessage_LiveShoppingDetails) ProtoReflect() proto
 
 // Deprecated: Use WebcastOecLiveShoppingMessage_LiveShoppingDetails.ProtoReflect.Descriptor instead.
 func (*WebcastOecLiveShoppingMessage_LiveShoppingDetails) Descriptor() ([]byte, []int) {
-	return file_webcast_proto_rawDescGZIP(), []int{33, 1}
+	return file_webcast_proto_rawDescGZIP(), []int{34, 1}
 }
 
 func (x *WebcastOecLiveShoppingMessage_LiveShoppingDetails) GetId1() string {
@@ -7403,1313 +7451,1316 @@ var file_webcast_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
 	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74,
 	0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x22, 0xae, 0x08, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
-	0x47, 0x69, 0x66, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x67, 0x69, 0x66, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x67, 0x69,
-	0x66, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x61,
-	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x06, 0x74, 0x6f, 0x55,
-	0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x06, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x67, 0x69,
-	0x66, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x67, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x6f,
-	0x6f, 0x6d, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x6f, 0x6d, 0x46, 0x61, 0x6e, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x67, 0x69,
-	0x66, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x67, 0x69, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x6f, 0x67, 0x49, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69,
-	0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x10, 0x75, 0x73,
-	0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x47, 0x69,
-	0x66, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x69,
-	0x66, 0x74, 0x52, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72,
-	0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x1a, 0x4a, 0x0a, 0x10,
-	0x55, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x82, 0x01, 0x0a, 0x0e, 0x47, 0x69, 0x66,
-	0x74, 0x49, 0x4d, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x37, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x22, 0xae, 0x08,
+	0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x06, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x74, 0x6f, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x67, 0x69, 0x66, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x67, 0x69,
+	0x66, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x6f, 0x6f, 0x6d, 0x46, 0x61, 0x6e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x72, 0x6f, 0x6f, 0x6d, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x67, 0x69, 0x66, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04,
+	0x67, 0x69, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x65, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x63, 0x69, 0x65,
+	0x76, 0x65, 0x72, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x63,
+	0x69, 0x65, 0x76, 0x65, 0x72, 0x1a, 0x4a, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x47, 0x69, 0x66,
+	0x74, 0x52, 0x65, 0x63, 0x69, 0x65, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0x82, 0x01, 0x0a, 0x0e, 0x47, 0x69, 0x66, 0x74, 0x49, 0x4d, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11,
 	0x73, 0x65, 0x6c, 0x66, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x66, 0x0a,
-	0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x12, 0x24, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x49,
-	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x70, 0x70, 0x72, 0x6f, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x73,
-	0x63, 0x61, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70,
-	0x72, 0x6f, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x73, 0x63, 0x61, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x57, 0x65, 0x6c, 0x63,
-	0x6f, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x57, 0x65, 0x6c,
-	0x63, 0x6f, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xb6, 0x0a, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
-	0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x12, 0x39, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x6d,
-	0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a,
-	0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x72, 0x69, 0x67, 0x68, 0x74, 0x49, 0x63, 0x6f, 0x6e,
-	0x12, 0x58, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x58, 0x0a, 0x0e, 0x66, 0x61,
-	0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72,
-	0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61,
-	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x52, 0x0e, 0x66, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x12, 0x64, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x34, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x69, 0x66,
-	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x98, 0x01, 0x0a, 0x19, 0x42,
-	0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72,
-	0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x80, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72,
-	0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x6f, 0x0a, 0x1d, 0x42, 0x61, 0x72, 0x72,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x69, 0x66,
-	0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x67, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
-	0x10, 0x73, 0x68, 0x6f, 0x77, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x47, 0x69, 0x66,
-	0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x2c, 0x0a, 0x0c, 0x42, 0x61, 0x72,
-	0x72, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x0b, 0x42, 0x61, 0x72, 0x72,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x43, 0x4f, 0x4d, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x43, 0x4f, 0x4d, 0x42, 0x55,
-	0x59, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c,
-	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10,
-	0x04, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x56, 0x49, 0x45, 0x57, 0x10, 0x05,
-	0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45,
-	0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49,
-	0x42, 0x45, 0x47, 0x49, 0x46, 0x54, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52,
-	0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x10, 0x08, 0x12, 0x21, 0x0a, 0x1d, 0x47, 0x52, 0x41,
-	0x44, 0x45, 0x55, 0x53, 0x45, 0x52, 0x45, 0x4e, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x45, 0x4e, 0x4f,
-	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10,
-	0x46, 0x41, 0x4e, 0x53, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45,
-	0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x41, 0x4e, 0x53, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x45,
-	0x4e, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d,
-	0x45, 0x50, 0x41, 0x52, 0x54, 0x4e, 0x45, 0x52, 0x53, 0x48, 0x49, 0x50, 0x10, 0x0c, 0x22, 0xdb,
-	0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x3d, 0x0a,
-	0x0b, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x84, 0x07, 0x0a,
-	0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x66, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x2c, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x49,
+	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0xdc,
+	0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x70,
+	0x70, 0x72, 0x6f, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x73, 0x63, 0x61, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x72, 0x6f, 0x74, 0x4c, 0x61, 0x6e, 0x64,
+	0x73, 0x63, 0x61, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x65,
+	0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x57, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x57, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x4f, 0x0a,
+	0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x69, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x72,
-	0x65, 0x65, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x65,
-	0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x32, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x32, 0x12, 0x24, 0x0a,
-	0x09, 0x67, 0x69, 0x66, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x67, 0x69, 0x66, 0x74, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1d, 0x0a, 0x06, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x42, 0x0a, 0x0a, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0d,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x57,
-	0x69, 0x74, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0a, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x26,
-	0x0a, 0x0e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x43, 0x68, 0x61,
-	0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
-	0x69, 0x74, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
-	0x79, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31,
-	0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x61, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x1a, 0x47, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51,
-	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a,
-	0x0e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x22, 0x85, 0x05, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x26,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x57, 0x65, 0x62, 0x63,
-	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3d,
-	0x0a, 0x16, 0x70, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x16, 0x70, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a,
-	0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x23, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x54, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x74, 0x79,
-	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x53,
-	0x74, 0x79, 0x6c, 0x65, 0x1a, 0xa4, 0x02, 0x0a, 0x05, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x1e,
-	0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4e, 0x6f, 0x12, 0x1b, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x0f, 0x76, 0x69, 0x6f, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x67, 0x6f, 0x74,
-	0x49, 0x74, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x67, 0x6f, 0x74, 0x49, 0x74, 0x42, 0x75, 0x74, 0x74,
-	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0f, 0x62, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42,
-	0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65,
-	0x78, 0x74, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x75, 0x74,
-	0x74, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x17,
-	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x09, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x09,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x6d, 0x73, 0x67,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d,
-	0x73, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa6, 0x05, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xb6,
+	0x0a, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
+	0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42,
+	0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61,
+	0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x62,
+	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x69, 0x67, 0x68, 0x74, 0x49, 0x63, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x0e, 0x75, 0x73, 0x65,
+	0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x58, 0x0a, 0x0e, 0x66, 0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x57, 0x65,
+	0x62, 0x63, 0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46,
+	0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0e, 0x66,
+	0x61, 0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x64, 0x0a,
+	0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52,
+	0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x1a, 0x98, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x80,
+	0x01, 0x0a, 0x19, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x61,
+	0x6e, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x1a, 0x6f, 0x0a, 0x1d, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x47, 0x69, 0x66, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x69, 0x66, 0x74, 0x53, 0x75,
+	0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x47, 0x69,
+	0x66, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x47, 0x69, 0x66, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x2c, 0x0a, 0x0c, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x84, 0x02, 0x0a, 0x0b, 0x42, 0x61, 0x72, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x45, 0x43, 0x4f, 0x4d, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x45, 0x43, 0x4f, 0x4d, 0x42, 0x55, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x56, 0x49, 0x45, 0x57, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x47, 0x49, 0x46, 0x54, 0x10,
+	0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45,
+	0x10, 0x08, 0x12, 0x21, 0x0a, 0x1d, 0x47, 0x52, 0x41, 0x44, 0x45, 0x55, 0x53, 0x45, 0x52, 0x45,
+	0x4e, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x45, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x41, 0x4e, 0x53, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x46,
+	0x41, 0x4e, 0x53, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x45, 0x4e, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x45,
+	0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d, 0x45, 0x50, 0x41, 0x52, 0x54, 0x4e, 0x45,
+	0x52, 0x53, 0x48, 0x49, 0x50, 0x10, 0x0c, 0x22, 0xdb, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x07,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52,
-	0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x1a, 0xf4, 0x03, 0x0a, 0x0c, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0c, 0x62, 0x75, 0x73,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
-	0x49, 0x64, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x49, 0x64, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69,
-	0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0e,
-	0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x73, 0x65,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
-	0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
-	0x12, 0x45, 0x0a, 0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f,
-	0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6e, 0x49,
-	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6e, 0x49, 0x64, 0x22,
-	0xe2, 0x03, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x44, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43,
+	0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x61,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x3d, 0x0a, 0x0b, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x84, 0x07, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
-	0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x69, 0x6e,
-	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f,
-	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x32,
-	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x52, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0f, 0x67, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x53, 0x74, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x53, 0x74, 0x72, 0x12, 0x10, 0x0a, 0x03,
-	0x70, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75,
-	0x6e, 0x70, 0x69, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
-	0x49, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x12, 0x2a, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x1a, 0x57, 0x65,
-	0x62, 0x63, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x7c, 0x0a,
-	0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xbc, 0x03, 0x0a, 0x19,
-	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x70, 0x53, 0x74, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x12, 0x44,
-	0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
-	0x1a, 0x68, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x9a, 0x02, 0x0a, 0x14, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x63,
-	0x61, 0x73, 0x74, 0x53, 0x75, 0x62, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x73, 0x75, 0x62, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x0d, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x43, 0x0a, 0x12, 0x6f, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x4f, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x12, 0x6f, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x53, 0x65,
-	0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x65, 0x6e, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x22, 0xed, 0x06, 0x0a,
-	0x18, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0b, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x08,
-	0x74, 0x61, 0x62, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x61,
-	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x74, 0x61, 0x62, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x30, 0x0a, 0x13, 0x69, 0x73, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x6f, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73,
-	0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x50, 0x6c, 0x61,
-	0x79, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x6f, 0x70, 0x46, 0x6f, 0x72, 0x4f, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
-	0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x46, 0x6f, 0x72,
-	0x4f, 0x66, 0x66, 0x1a, 0x88, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x61, 0x62, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65,
-	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52,
-	0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69,
-	0x73, 0x74, 0x4c, 0x79, 0x6e, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x79, 0x6e, 0x78, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xfb,
-	0x02, 0x0a, 0x0a, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x2d, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x68, 0x6f, 0x77, 0x45, 0x6e,
-	0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x73, 0x68, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x61,
-	0x6e, 0x63, 0x65, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x54,
-	0x61, 0x62, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a,
-	0x0a, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x6f, 0x6e, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x6f, 0x6e, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xf6, 0x09, 0x0a,
-	0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0f,
+	0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x62,
+	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x30,
+	0x0a, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x65, 0x78, 0x74,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66, 0x75, 0x6c,
+	0x6c, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x34, 0x0a, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x56, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x56, 0x32, 0x12, 0x24, 0x0a, 0x09, 0x67, 0x69, 0x66, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x09, 0x67, 0x69, 0x66, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x06, 0x61, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x06, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x0a, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x69, 0x63, 0x6b,
+	0x43, 0x68, 0x61, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12,
+	0x36, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x61, 0x67,
+	0x67, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x61, 0x0a, 0x14, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x47, 0x0a,
+	0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x0e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c,
+	0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x45, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x22, 0x85, 0x05, 0x0a,
+	0x15, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x3d, 0x0a, 0x16, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x78,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x16,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x75,
+	0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x1a, 0xa4, 0x02,
+	0x0a, 0x05, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x12, 0x1b, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x2f, 0x0a, 0x0f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x0f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x67, 0x6f, 0x74, 0x49, 0x74, 0x42, 0x75, 0x74, 0x74, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0b,
+	0x67, 0x6f, 0x74, 0x49, 0x74, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0f, 0x62,
+	0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0f, 0x62, 0x61, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
+	0x45, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x09,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x09, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0c,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
+	0xa6, 0x05, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0c, 0x65,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x1a, 0xf4, 0x03, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x63, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x61, 0x6d, 0x6f,
+	0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x6f, 0x70, 0x6c,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x65,
+	0x6f, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x70,
+	0x61, 0x63, 0x6b, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x6e, 0x70,
+	0x61, 0x63, 0x6b, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x6b, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xe2, 0x03, 0x0a, 0x18, 0x57, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x53, 0x65, 0x74, 0x54,
-	0x6f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73,
-	0x53, 0x65, 0x74, 0x54, 0x6f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
-	0x54, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
-	0x73, 0x54, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x61, 0x6e,
-	0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x55, 0x73, 0x65,
-	0x72, 0x4e, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x55, 0x73,
-	0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2c, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x12, 0x50, 0x0a, 0x11, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x45,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x11, 0x62, 0x61,
-	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x32, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x62,
-	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x32,
-	0x12, 0x33, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65,
-	0x78, 0x74, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45,
-	0x6e, 0x74, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a,
-	0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xab, 0x02, 0x0a, 0x0c, 0x45, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x04,
-	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x50, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x12, 0x19, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x22, 0x0a, 0x08, 0x74, 0x65, 0x78, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x74, 0x65, 0x78,
-	0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x79, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x79, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6e, 0x69, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x6e, 0x69, 0x6d, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67,
-	0x65, 0x12, 0x32, 0x0a, 0x14, 0x66, 0x6c, 0x65, 0x78, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x41, 0x72, 0x72, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x14, 0x66, 0x6c, 0x65, 0x78, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
-	0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x49, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x19, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
+	0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x53, 0x74, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x53, 0x74, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x22, 0x93, 0x01,
+	0x0a, 0x16, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x49, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x49, 0x64,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x1a, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x49, 0x6e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x7c, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
-	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70,
-	0x6f, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x6f,
-	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x0a,
-	0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x45, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a,
-	0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x6f, 0x6c, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x6f, 0x6c, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x19, 0x57, 0x65, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x84, 0x01,
-	0x0a, 0x0f, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x31, 0x22, 0xb7, 0x02, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
-	0x52, 0x61, 0x6e, 0x6b, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0xbc, 0x03, 0x0a, 0x19, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x09,
+	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x57, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x71, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x6f, 0x72, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x1a, 0x68, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x22, 0x9a, 0x02, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe2, 0x02, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x75, 0x62, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x6f, 0x6c,
+	0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x4f, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x6f, 0x6c, 0x64,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x40, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x22, 0xed, 0x06, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x74, 0x61, 0x62, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x73, 0x41, 0x6e,
+	0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6e,
+	0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x46, 0x6f, 0x72, 0x4f, 0x66,
+	0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x46, 0x6f, 0x72, 0x4f, 0x66, 0x66, 0x1a, 0x88, 0x01, 0x0a,
+	0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23,
+	0x0a, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x79, 0x6e, 0x78, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4c,
+	0x79, 0x6e, 0x78, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xfb, 0x02, 0x0a, 0x0a, 0x52, 0x61, 0x6e, 0x6b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b,
+	0x12, 0x2d, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52,
+	0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x15, 0x73, 0x68, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x73, 0x68, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x69, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61,
+	0x62, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x61, 0x6e, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x68, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x6f, 0x6e, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x6f,
+	0x6e, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xf6, 0x09, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x73, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x54, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x54, 0x6f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x70, 0x53, 0x74,
+	0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x12,
+	0x50, 0x0a, 0x11, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x30, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x32, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x32, 0x12, 0x33, 0x0a, 0x11, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x11, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c,
+	0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73,
+	0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x1a, 0xab, 0x02, 0x0a, 0x0c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x12,
+	0x19, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x54, 0x65, 0x78, 0x74, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x74, 0x65, 0x78, 0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6e,
+	0x69, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x61, 0x6e, 0x69, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x66, 0x6c,
+	0x65, 0x78, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x14, 0x66, 0x6c, 0x65, 0x78, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc0,
+	0x02, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x35,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x45, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x50, 0x6f, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x6c, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x6c, 0x4b, 0x69, 0x6e,
+	0x64, 0x22, 0x89, 0x02, 0x0a, 0x19, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x78, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x78, 0x42, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x78, 0x41, 0x66, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64,
-	0x78, 0x41, 0x66, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0f,
-	0x73, 0x65, 0x6c, 0x66, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0f, 0x73, 0x65,
-	0x6c, 0x66, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a,
-	0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73,
-	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x10,
-	0x6f, 0x74, 0x68, 0x65, 0x72, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73, 0x67,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x75, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd5,
-	0x05, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79,
-	0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x57, 0x65, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74,
-	0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x1a,
-	0xc4, 0x04, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x55, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x6b,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x32, 0x12, 0x24, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x58, 0x0a, 0x0c, 0x72, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x34, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79,
-	0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x44, 0x61, 0x74, 0x61, 0x32, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x64,
-	0x61, 0x74, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61,
-	0x74, 0x61, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34,
-	0x1a, 0x5f, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x24, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x1a, 0xa2, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x24,
-	0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x12, 0x44, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x22, 0xb7, 0x02,
+	0x0a, 0x16, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x54, 0x65, 0x78,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x65,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12,
+	0x32, 0x0a, 0x14, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x78, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x78, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x78, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x78, 0x41, 0x66, 0x74, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x75, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x75,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd5, 0x05, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x75,
+	0x72, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x1a, 0xc4, 0x04, 0x0a, 0x0d, 0x52, 0x61, 0x6e,
+	0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61,
+	0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31,
+	0x12, 0x55, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x48,
+	0x6f, 0x75, 0x72, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x24, 0x0a,
+	0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x58, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x64, 0x61,
+	0x74, 0x61, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x32, 0x52,
+	0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61,
+	0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x1a, 0x5f, 0x0a, 0x0b, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x24,
+	0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x08, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61,
-	0x74, 0x61, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x64, 0x61, 0x74, 0x61, 0x35, 0x22, 0x96, 0x03, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x41, 0x72, 0x6d, 0x69, 0x65, 0x73, 0x12,
-	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x35, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x41,
-	0x72, 0x6d, 0x69, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x74,
-	0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x32, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74,
-	0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74,
-	0x61, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x1c, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x18, 0x0c, 0x20, 0x01,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x1a, 0xa2, 0x01, 0x0a, 0x0c, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x24, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61,
+	0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74,
-	0x61, 0x35, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x35, 0x22,
-	0xae, 0x03, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
-	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x31, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x49, 0x64, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x34, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x49,
-	0x64, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x49, 0x64, 0x32, 0x12, 0x55, 0x0a,
-	0x05, 0x44, 0x61, 0x74, 0x61, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74,
-	0x74, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x75, 0x6e,
-	0x69, 0x73, 0x68, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x44,
-	0x61, 0x74, 0x61, 0x36, 0x1a, 0xb9, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63,
+	0x61, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x35, 0x22,
+	0x96, 0x03, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
+	0x69, 0x63, 0x41, 0x72, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x62, 0x61, 0x74,
+	0x74, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x41, 0x72, 0x6d, 0x69, 0x65, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69,
+	0x64, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x31,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x32,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x32, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
+	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
+	0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x61, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x1c,
+	0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x61, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x61, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x35, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x35, 0x22, 0xae, 0x03, 0x0a, 0x20, 0x57, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x1f, 0x0a,
+	0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x49, 0x64, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x49, 0x64, 0x31,
+	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44,
+	0x61, 0x74, 0x61, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x49, 0x64, 0x32, 0x12, 0x55, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x36, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63,
 	0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x46, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x32, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x49, 0x64, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x33, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x33, 0x12, 0x10, 0x0a, 0x03,
-	0x49, 0x64, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x49, 0x64, 0x31, 0x12, 0x14,
-	0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44,
-	0x61, 0x74, 0x61, 0x35, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x36, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61,
-	0x74, 0x61, 0x38, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x38,
-	0x22, 0xc3, 0x03, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
-	0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x32, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x32, 0x12, 0x4c, 0x0a, 0x05, 0x44,
-	0x61, 0x74, 0x61, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x57, 0x65, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6e,
+	0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x36, 0x1a, 0xb9, 0x01,
+	0x0a, 0x1d, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50,
+	0x75, 0x6e, 0x69, 0x73, 0x68, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x10, 0x0a, 0x03, 0x49, 0x64, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x49, 0x64,
+	0x32, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x44, 0x61, 0x74, 0x61, 0x33, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x31, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x49, 0x64, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x35,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x35, 0x12, 0x14, 0x0a,
+	0x05, 0x44, 0x61, 0x74, 0x61, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61,
+	0x74, 0x61, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x38, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x38, 0x22, 0xc3, 0x03, 0x0a, 0x1f, 0x57, 0x65,
+	0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44,
+	0x61, 0x74, 0x61, 0x32, 0x12, 0x4c, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x33, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74,
+	0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x44, 0x61, 0x74,
+	0x61, 0x33, 0x12, 0x4d, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x6d,
+	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x32, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61,
+	0x35, 0x1a, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x05, 0x44, 0x61,
+	0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61,
+	0x31, 0x1a, 0x26, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31, 0x1a, 0x44, 0x0a, 0x16, 0x4c, 0x69, 0x6e,
 	0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x33, 0x12, 0x4d, 0x0a, 0x05, 0x44, 0x61, 0x74,
-	0x61, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x6d,
-	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61,
-	0x32, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x35, 0x1a, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b,
-	0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x45, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69,
-	0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31, 0x1a, 0x26, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x74,
-	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61,
-	0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31,
-	0x1a, 0x44, 0x0a, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61,
-	0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31,
-	0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x44, 0x61, 0x74, 0x61, 0x32, 0x22, 0xc9, 0x12, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x12,
-	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x4d, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
-	0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x62, 0x61, 0x74,
-	0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x57, 0x65, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x4d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e,
+	0x74, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74,
+	0x61, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x44, 0x61, 0x74, 0x61, 0x32, 0x22,
+	0xc9, 0x12, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
+	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x0c, 0x62, 0x61, 0x74,
+	0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63,
+	0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e,
 	0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
-	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x73, 0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x43,
-	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69,
-	0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
-	0x61, 0x74, 0x74, 0x6c, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x54,
-	0x65, 0x61, 0x6d, 0x12, 0x47, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c,
-	0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d,
-	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x31, 0x36, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x31, 0x36, 0x12, 0x43, 0x0a, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x32, 0x76,
-	0x32, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x32, 0x76, 0x32, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x32, 0x76, 0x32, 0x1a, 0x95, 0x02, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74,
-	0x32, 0x76, 0x32, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x61,
-	0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x57,
+	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74,
+	0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x43, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x57, 0x65, 0x62, 0x63,
 	0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x32, 0x76, 0x32, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x33, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x33, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x58, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x1a,
-	0xaf, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x10, 0x0a,
-	0x03, 0x69, 0x64, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x32, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x34, 0x1a, 0x6c, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74,
-	0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
-	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x47, 0x0a, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74,
+	0x74, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x74, 0x65, 0x61,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x36, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x31, 0x36, 0x12, 0x43, 0x0a, 0x0b, 0x68,
+	0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x32, 0x76, 0x32, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69,
+	0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x32, 0x76, 0x32, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x32, 0x76, 0x32,
+	0x1a, 0x95, 0x02, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x32, 0x76, 0x32, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x46, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x32, 0x76,
+	0x32, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x33, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x58,
+	0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x1a, 0xaf, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x6e,
+	0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69,
+	0x64, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x1a, 0x6c, 0x0a, 0x15, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x7f, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b,
+	0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61,
+	0x74, 0x61, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0xf3, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x60, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
+	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x1a, 0x69, 0x0a, 0x1b, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a,
+	0xce, 0x03, 0x0a, 0x17, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5e, 0x0a, 0x0b, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69,
 	0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
-	0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x7f, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69,
-	0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x77,
-	0x69, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x33, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x1a, 0xf3, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74,
-	0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x60, 0x0a, 0x07, 0x73, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x57, 0x65, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61,
-	0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x69, 0x0a, 0x1b, 0x4c,
-	0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x32, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0xce, 0x03, 0x0a, 0x17, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
-	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x5e, 0x0a, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x6f, 0x70, 0x56,
-	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x1a, 0xc2, 0x02, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x5e, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c,
-	0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65,
-	0x77, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x52, 0x06, 0x76,
-	0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x2e,
+	0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0xc2, 0x02, 0x0a, 0x0e,
+	0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x5e,
+	0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46,
+	0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74,
+	0x74, 0x6c, 0x65, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x54, 0x6f, 0x70,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x4f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0f, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x4f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x4f, 0x72,
-	0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x1a, 0x8d, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x56,
-	0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x1a, 0xe8, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b,
-	0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a,
-	0x09, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69,
-	0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42,
-	0x61, 0x74, 0x74, 0x6c, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0xf1,
-	0x01, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4a, 0x0a, 0x04,
-	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x57, 0x65, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x48,
-	0x6f, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x48, 0x6f,
-	0x73, 0x74, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x68, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e,
-	0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x13, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f,
-	0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x13, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f,
-	0x74, 0x69, 0x63, 0x65, 0x22, 0xbb, 0x03, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a,
+	0x1a, 0x8d, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x1a, 0xe8, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x09, 0x68, 0x6f,
+	0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0xf1, 0x01, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4a, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x4d, 0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
+	0x69, 0x63, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x1a, 0x68, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1d,
+	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x46, 0x61,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a,
 	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2e,
-	0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x69, 0x6e,
-	0x6b, 0x6d, 0x69, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x66, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x15,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x46, 0x61, 0x6e, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64,
-	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x68, 0x65, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x69,
-	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55,
-	0x69, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69,
-	0x76, 0x65, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x4d,
+	0x0a, 0x13, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x46, 0x61,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x22, 0xbb, 0x03,
+	0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x69, 0x63,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c,
+	0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x6e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x69, 0x63, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x4d,
+	0x69, 0x63, 0x46, 0x61, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x69, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x17,
+	0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x72, 0x6f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0e, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x52, 0x0e, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54,
+	0x65, 0x78, 0x74, 0x22, 0x96, 0x05, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x55, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x57, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x1a, 0x80, 0x01, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x52, 0x65,
+	0x63, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x61, 0x70, 0x69, 0x52, 0x65, 0x63, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x61, 0x70, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x47, 0x6f, 0x69, 0x6d, 0x4d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x70, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x54,
+	0x6f, 0x47, 0x6f, 0x69, 0x6d, 0x4d, 0x73, 0x1a, 0xe2, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x48, 0x74, 0x74, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x48, 0x74, 0x74, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x75, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x50, 0x32, 0x50, 0x4d, 0x73, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x50, 0x32, 0x50, 0x4d,
+	0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70,
+	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x22, 0xd0, 0x05, 0x0a,
+	0x1d, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x65, 0x63, 0x4c, 0x69, 0x76, 0x65, 0x53,
+	0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
 	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
 	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x41,
-	0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x61,
-	0x64, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x42, 0x61, 0x64,
-	0x67, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xcb, 0x01, 0x0a,
-	0x20, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0e, 0x6e, 0x69,
-	0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x0e, 0x6e, 0x69, 0x63, 0x6b, 0x4e,
-	0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
-	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
-	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x65,
-	0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52,
-	0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x22, 0x96, 0x05, 0x0a, 0x17, 0x57,
-	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
-	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
-	0x72, 0x6f, 0x6d, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x1a, 0x80, 0x01, 0x0a, 0x08,
-	0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x52, 0x65, 0x63, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x70, 0x69, 0x52, 0x65, 0x63, 0x76, 0x54, 0x69,
-	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x70, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x54,
-	0x6f, 0x47, 0x6f, 0x69, 0x6d, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61,
-	0x70, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x47, 0x6f, 0x69, 0x6d, 0x4d, 0x73, 0x1a, 0xe2,
-	0x01, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x48, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x75,
-	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x48, 0x74, 0x74, 0x70, 0x12,
-	0x34, 0x0a, 0x15, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x57,
-	0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
-	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x53,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x50,
-	0x32, 0x50, 0x4d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x50, 0x32, 0x50, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x73, 0x67, 0x12,
-	0x22, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6d,
-	0x69, 0x7a, 0x65, 0x22, 0xd0, 0x05, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4f,
-	0x65, 0x63, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65,
+	0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x4b, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x4f, 0x65, 0x63, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x68,
+	0x6f, 0x70, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x57, 0x65, 0x62,
+	0x63, 0x61, 0x73, 0x74, 0x4f, 0x65, 0x63, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x53,
+	0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x90, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x76, 0x65,
+	0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61,
+	0x74, 0x61, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x61, 0x32, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x32, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x32, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x34, 0x1a, 0x92, 0x01, 0x0a, 0x13, 0x4c,
+	0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x69, 0x64, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61,
+	0x74, 0x61, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa6, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x50,
+	0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x61, 0x0a, 0x1b, 0x57, 0x65, 0x62, 0x63,
+	0x61, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x72, 0x6f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x54, 0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x57,
+	0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd1,
+	0x0d, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x4b, 0x0a, 0x08,
-	0x73, 0x68, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
-	0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x65, 0x63, 0x4c, 0x69, 0x76, 0x65, 0x53,
-	0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c,
-	0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x73, 0x68, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x68, 0x6f,
-	0x70, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x4c, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x32, 0x2e, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x65, 0x63, 0x4c, 0x69,
-	0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x90,
-	0x02, 0x0a, 0x10, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x55,
-	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x55, 0x72,
-	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f,
-	0x70, 0x55, 0x72, 0x6c, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f,
-	0x70, 0x55, 0x72, 0x6c, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x33, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x34, 0x1a, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x63, 0x61,
-	0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1f,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x61, 0x0a, 0x1b, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61,
-	0x6d, 0x65, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x53,
+	0x63, 0x65, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37,
+	0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x45, 0x6e, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0e,
+	0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4b, 0x69, 0x63,
+	0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x4b, 0x69, 0x63,
+	0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x17, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x17, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a,
+	0x18, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x18, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x4d, 0x75,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x49, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x4d, 0x69, 0x63, 0x49,
+	0x64, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x69,
+	0x63, 0x49, 0x64, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x13, 0x4d, 0x69, 0x63, 0x49, 0x64, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x52,
+	0x0a, 0x17, 0x43, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x17, 0x43, 0x6f, 0x68, 0x6f, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a,
+	0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x53,
+	0x79, 0x73, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x53,
+	0x79, 0x73, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x11, 0x53, 0x79, 0x73, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x12, 0x29, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x18, 0xca, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x22, 0xc0, 0x0a, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
 	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
 	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12,
-	0x21, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x54, 0x65,
-	0x78, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd1, 0x0d, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x63, 0x61, 0x73,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
-	0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x05, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x53,
-	0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x0c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37,
-	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0e, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x0e, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x58, 0x0a, 0x17, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b,
-	0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x52, 0x17, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x52, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x18, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x57,
-	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x18, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x34, 0x0a, 0x0b, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4d,
-	0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x4d, 0x75, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12,
-	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x4c, 0x0a, 0x13, 0x4d, 0x69, 0x63, 0x49, 0x64, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x69, 0x63, 0x49, 0x64, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x4d, 0x69, 0x63, 0x49, 0x64, 0x78,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x17, 0x43, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x17, 0x43, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x12, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f,
-	0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x53, 0x79, 0x73, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x53, 0x79, 0x73, 0x4b, 0x69, 0x63, 0x6b,
-	0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x66,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x6d, 0x69, 0x63, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x10,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x15, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x29, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x22, 0xc0, 0x0a, 0x0a, 0x17, 0x57, 0x65,
-	0x62, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x73, 0x63, 0x65,
-	0x6e, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a,
-	0x11, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6c, 0x69,
-	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x34, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c,
-	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x69,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x69,
-	0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a,
-	0x12, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x6b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4b, 0x69, 0x63,
-	0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x6b, 0x69, 0x63,
-	0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x63,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x63, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x46, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x66,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x11, 0x6a, 0x6f, 0x69, 0x6e,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x70, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x10, 0x6a, 0x6f,
-	0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x71,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x4a, 0x6f,
-	0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x74, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4a, 0x6f,
-	0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x32, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x75, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x15, 0x70, 0x32, 0x70, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x3b, 0x0a, 0x0f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x42, 0x75, 0x73, 0x69,
-	0x6e, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x62, 0x75, 0x73,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a,
-	0x11, 0x52, 0x6f, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f,
-	0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52,
-	0x6f, 0x6f, 0x6d, 0x42, 0x16, 0x5a, 0x14, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x67,
-	0x6f, 0x74, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x6c, 0x69, 0x76, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x53,
+	0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x11, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31,
+	0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x68,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x6b,
+	0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x6b, 0x69, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x40, 0x0a, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x10, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x71, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x10, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x74, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x32,
+	0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x75, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x50, 0x32, 0x50, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x15, 0x70, 0x32, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0f, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6f, 0x6d, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x42, 0x16, 0x5a, 0x14,
+	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x67, 0x6f, 0x74, 0x69, 0x6b, 0x74, 0x6f, 0x6b,
+	0x6c, 0x69, 0x76, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8725,382 +8776,384 @@ func file_webcast_proto_rawDescGZIP() []byte {
 }
 
 var file_webcast_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_webcast_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_webcast_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_webcast_proto_goTypes = []any{
 	(WebcastBarrageMessage_BarrageType)(0),                      // 0: WebcastBarrageMessage.BarrageType
 	(*WebcastPushFrame)(nil),                                    // 1: WebcastPushFrame
 	(*WebcastResponse)(nil),                                     // 2: WebcastResponse
-	(*WebcastGiftMessage)(nil),                                  // 3: WebcastGiftMessage
-	(*RoomMessage)(nil),                                         // 4: RoomMessage
-	(*WebcastRoomMessage)(nil),                                  // 5: WebcastRoomMessage
-	(*WebcastBarrageMessage)(nil),                               // 6: WebcastBarrageMessage
-	(*WebcastCaptionMessage)(nil),                               // 7: WebcastCaptionMessage
-	(*WebcastChatMessage)(nil),                                  // 8: WebcastChatMessage
-	(*WebcastControlMessage)(nil),                               // 9: WebcastControlMessage
-	(*WebcastEmoteChatMessage)(nil),                             // 10: WebcastEmoteChatMessage
-	(*WebcastEnvelopeMessage)(nil),                              // 11: WebcastEnvelopeMessage
-	(*WebcastGoalUpdateMessage)(nil),                            // 12: WebcastGoalUpdateMessage
-	(*WebcastImDeleteMessage)(nil),                              // 13: WebcastImDeleteMessage
-	(*WebcastInRoomBannerMessage)(nil),                          // 14: WebcastInRoomBannerMessage
-	(*WebcastLikeMessage)(nil),                                  // 15: WebcastLikeMessage
-	(*WebcastRoomUserSeqMessage)(nil),                           // 16: WebcastRoomUserSeqMessage
-	(*WebcastSocialMessage)(nil),                                // 17: WebcastSocialMessage
-	(*WebcastSubNotifyMessage)(nil),                             // 18: WebcastSubNotifyMessage
-	(*WebcastRankUpdateMessage)(nil),                            // 19: WebcastRankUpdateMessage
-	(*WebcastMemberMessage)(nil),                                // 20: WebcastMemberMessage
-	(*WebcastPollMessage)(nil),                                  // 21: WebcastPollMessage
-	(*WebcastQuestionNewMessage)(nil),                           // 22: WebcastQuestionNewMessage
-	(*WebcastRankTextMessage)(nil),                              // 23: WebcastRankTextMessage
-	(*WebcastHourlyRankMessage)(nil),                            // 24: WebcastHourlyRankMessage
-	(*WebcastLinkMicArmies)(nil),                                // 25: WebcastLinkMicArmies
-	(*WebcastLinkMicBattlePunishFinish)(nil),                    // 26: WebcastLinkMicBattlePunishFinish
-	(*WebcastLinkmicBattleTaskMessage)(nil),                     // 27: WebcastLinkmicBattleTaskMessage
-	(*WebcastLinkMicBattle)(nil),                                // 28: WebcastLinkMicBattle
-	(*WebcastLinkMicFanTicketMethod)(nil),                       // 29: WebcastLinkMicFanTicketMethod
-	(*WebcastLinkMicMethod)(nil),                                // 30: WebcastLinkMicMethod
-	(*WebcastLiveIntroMessage)(nil),                             // 31: WebcastLiveIntroMessage
-	(*WebcastUnauthorizedMemberMessage)(nil),                    // 32: WebcastUnauthorizedMemberMessage
-	(*WebcastMsgDetectMessage)(nil),                             // 33: WebcastMsgDetectMessage
-	(*WebcastOecLiveShoppingMessage)(nil),                       // 34: WebcastOecLiveShoppingMessage
-	(*WebcastRoomPinMessage)(nil),                               // 35: WebcastRoomPinMessage
-	(*WebcastLiveGameIntroMessage)(nil),                         // 36: WebcastLiveGameIntroMessage
-	(*WebcastSystemMessage)(nil),                                // 37: WebcastSystemMessage
-	(*WebcastLinkMessage)(nil),                                  // 38: WebcastLinkMessage
-	(*WebcastLinkLayerMessage)(nil),                             // 39: WebcastLinkLayerMessage
-	(*RoomVerifyMessage)(nil),                                   // 40: RoomVerifyMessage
-	nil,                                                         // 41: WebcastPushFrame.HeadersEntry
-	nil,                                                         // 42: WebcastResponse.RouteParamsMapEntry
-	(*WebcastResponse_Message)(nil),                             // 43: WebcastResponse.Message
-	(*WebcastGiftMessage_UserGiftReciever)(nil),                 // 44: WebcastGiftMessage.UserGiftReciever
-	(*WebcastGiftMessage_GiftIMPriority)(nil),                   // 45: WebcastGiftMessage.GiftIMPriority
-	(*WebcastGiftMessage_PublicAreaCommon)(nil),                 // 46: WebcastGiftMessage.PublicAreaCommon
-	(*WebcastBarrageMessage_BarrageTypeUserGradeParam)(nil),     // 47: WebcastBarrageMessage.BarrageTypeUserGradeParam
-	(*WebcastBarrageMessage_BarrageTypeFansLevelParam)(nil),     // 48: WebcastBarrageMessage.BarrageTypeFansLevelParam
-	(*WebcastBarrageMessage_BarrageTypeSubscribeGiftParam)(nil), // 49: WebcastBarrageMessage.BarrageTypeSubscribeGiftParam
-	(*WebcastBarrageMessage_BarrageEvent)(nil),                  // 50: WebcastBarrageMessage.BarrageEvent
-	(*WebcastCaptionMessage_CaptionData)(nil),                   // 51: WebcastCaptionMessage.CaptionData
-	nil, // 52: WebcastChatMessage.CommentQualityScoresEntry
-	(*WebcastChatMessage_EmoteWithIndex)(nil),                                     // 53: WebcastChatMessage.EmoteWithIndex
-	(*WebcastControlMessage_Extra)(nil),                                           // 54: WebcastControlMessage.Extra
-	(*WebcastEnvelopeMessage_EnvelopeInfo)(nil),                                   // 55: WebcastEnvelopeMessage.EnvelopeInfo
-	(*WebcastRoomUserSeqMessage_Contributor)(nil),                                 // 56: WebcastRoomUserSeqMessage.Contributor
-	(*WebcastRankUpdateMessage_RankTabInfo)(nil),                                  // 57: WebcastRankUpdateMessage.RankTabInfo
-	(*WebcastRankUpdateMessage_RankUpdate)(nil),                                   // 58: WebcastRankUpdateMessage.RankUpdate
-	(*WebcastMemberMessage_EffectConfig)(nil),                                     // 59: WebcastMemberMessage.EffectConfig
-	(*WebcastQuestionNewMessage_QuestionDetails)(nil),                             // 60: WebcastQuestionNewMessage.QuestionDetails
-	(*WebcastHourlyRankMessage_RankContainer)(nil),                                // 61: WebcastHourlyRankMessage.RankContainer
-	(*WebcastHourlyRankMessage_RankContainer_RankingData)(nil),                    // 62: WebcastHourlyRankMessage.RankContainer.RankingData
-	(*WebcastHourlyRankMessage_RankContainer_RankingData2)(nil),                   // 63: WebcastHourlyRankMessage.RankContainer.RankingData2
-	(*WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData)(nil),        // 64: WebcastLinkMicBattlePunishFinish.LinkMicBattlePunishFinishData
-	(*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData)(nil),                 // 65: WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData
-	(*WebcastLinkmicBattleTaskMessage_BattleTaskData)(nil),                        // 66: WebcastLinkmicBattleTaskMessage.BattleTaskData
-	(*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2)(nil),                // 67: WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData2
-	(*WebcastLinkMicBattle_Host2V2Data)(nil),                                      // 68: WebcastLinkMicBattle.Host2v2Data
-	(*WebcastLinkMicBattle_LinkMicBattleConfig)(nil),                              // 69: WebcastLinkMicBattle.LinkMicBattleConfig
-	(*WebcastLinkMicBattle_LinkMicBattleTeamData)(nil),                            // 70: WebcastLinkMicBattle.LinkMicBattleTeamData
-	(*WebcastLinkMicBattle_LinkMicBattleData)(nil),                                // 71: WebcastLinkMicBattle.LinkMicBattleData
-	(*WebcastLinkMicBattle_LinkMicBattleDetails)(nil),                             // 72: WebcastLinkMicBattle.LinkMicBattleDetails
-	(*WebcastLinkMicBattle_LinkMicBattleTopViewers)(nil),                          // 73: WebcastLinkMicBattle.LinkMicBattleTopViewers
-	(*WebcastLinkMicBattle_LinkMicBattleHost)(nil),                                // 74: WebcastLinkMicBattle.LinkMicBattleHost
-	(*WebcastLinkMicBattle_Host2V2Data_HostData)(nil),                             // 75: WebcastLinkMicBattle.Host2v2Data.HostData
-	(*WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary)(nil), // 76: WebcastLinkMicBattle.LinkMicBattleDetails.LinkMicBattleDetailsSummary
-	(*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup)(nil),           // 77: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup
-	(*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer)(nil), // 78: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.TopViewer
-	(*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup)(nil),                      // 79: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup
-	(*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host)(nil),                 // 80: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.Host
-	(*WebcastMsgDetectMessage_TimeInfo)(nil),                                      // 81: WebcastMsgDetectMessage.TimeInfo
-	(*WebcastMsgDetectMessage_TriggerCondition)(nil),                              // 82: WebcastMsgDetectMessage.TriggerCondition
-	(*WebcastOecLiveShoppingMessage_LiveShoppingData)(nil),                        // 83: WebcastOecLiveShoppingMessage.LiveShoppingData
-	(*WebcastOecLiveShoppingMessage_LiveShoppingDetails)(nil),                     // 84: WebcastOecLiveShoppingMessage.LiveShoppingDetails
-	(*Common)(nil),                         // 85: Common
-	(*User)(nil),                           // 86: User
-	(*GiftStruct)(nil),                     // 87: GiftStruct
-	(*UserIdentity)(nil),                   // 88: UserIdentity
-	(*Image)(nil),                          // 89: Image
-	(*Text)(nil),                           // 90: Text
-	(ControlAction)(0),                     // 91: ControlAction
-	(*PunishEventInfo)(nil),                // 92: PunishEventInfo
-	(*Emote)(nil),                          // 93: Emote
-	(*MsgFilter)(nil),                      // 94: MsgFilter
-	(EnvelopeDisplay)(0),                   // 95: EnvelopeDisplay
-	(*Indicator)(nil),                      // 96: Indicator
-	(*Goal)(nil),                           // 97: Goal
-	(SubscribeType)(0),                     // 98: SubscribeType
-	(OldSubscribeStatus)(0),                // 99: OldSubscribeStatus
-	(SubscribingStatus)(0),                 // 100: SubscribingStatus
-	(MemberMessageAction)(0),               // 101: MemberMessageAction
-	(MessageType)(0),                       // 102: MessageType
-	(*PollStartContent)(nil),               // 103: PollStartContent
-	(*PollEndContent)(nil),                 // 104: PollEndContent
-	(*PollUpdateVotesContent)(nil),         // 105: PollUpdateVotesContent
-	(*LinkMicArmiesItems)(nil),             // 106: LinkMicArmiesItems
-	(LinkMicBattleStatus)(0),               // 107: LinkMicBattleStatus
-	(*FanTicketRoomNoticeContent)(nil),     // 108: FanTicketRoomNoticeContent
-	(AuditStatus)(0),                       // 109: AuditStatus
-	(*BadgeStruct)(nil),                    // 110: BadgeStruct
-	(*TimeStampContainer)(nil),             // 111: TimeStampContainer
-	(LinkMessageType)(0),                   // 112: LinkMessageType
-	(Scene)(0),                             // 113: Scene
-	(*LinkerInviteContent)(nil),            // 114: LinkerInviteContent
-	(*LinkerReplyContent)(nil),             // 115: LinkerReplyContent
-	(*LinkerCreateContent)(nil),            // 116: LinkerCreateContent
-	(*LinkerCloseContent)(nil),             // 117: LinkerCloseContent
-	(*LinkerEnterContent)(nil),             // 118: LinkerEnterContent
-	(*LinkerLeaveContent)(nil),             // 119: LinkerLeaveContent
-	(*LinkerCancelContent)(nil),            // 120: LinkerCancelContent
-	(*LinkerKickOutContent)(nil),           // 121: LinkerKickOutContent
-	(*LinkerLinkedListChangeContent)(nil),  // 122: LinkerLinkedListChangeContent
-	(*LinkerUpdateUserContent)(nil),        // 123: LinkerUpdateUserContent
-	(*LinkerWaitingListChangeContent)(nil), // 124: LinkerWaitingListChangeContent
-	(*LinkerMuteContent)(nil),              // 125: LinkerMuteContent
-	(*LinkerRandomMatchContent)(nil),       // 126: LinkerRandomMatchContent
-	(*LinkerUpdateUserSettingContent)(nil), // 127: LinkerUpdateUserSettingContent
-	(*LinkerMicIdxUpdateContent)(nil),      // 128: LinkerMicIdxUpdateContent
-	(*LinkerListChangeContent)(nil),        // 129: LinkerListChangeContent
-	(*CohostListChangeContent)(nil),        // 130: CohostListChangeContent
-	(*LinkerMediaChangeContent)(nil),       // 131: LinkerMediaChangeContent
-	(*LinkerAcceptNoticeContent)(nil),      // 132: LinkerAcceptNoticeContent
-	(*LinkerSysKickOutContent)(nil),        // 133: LinkerSysKickOutContent
-	(*LinkmicUserToastContent)(nil),        // 134: LinkmicUserToastContent
-	(*CreateChannelContent)(nil),           // 135: CreateChannelContent
-	(*ListChangeContent)(nil),              // 136: ListChangeContent
-	(*InviteContent)(nil),                  // 137: InviteContent
-	(*ApplyContent)(nil),                   // 138: ApplyContent
-	(*PermitApplyContent)(nil),             // 139: PermitApplyContent
-	(*ReplyInviteContent)(nil),             // 140: ReplyInviteContent
-	(*KickOutContent)(nil),                 // 141: KickOutContent
-	(*CancelApplyContent)(nil),             // 142: CancelApplyContent
-	(*CancelInviteContent)(nil),            // 143: CancelInviteContent
-	(*LeaveContent)(nil),                   // 144: LeaveContent
-	(*FinishChannelContent)(nil),           // 145: FinishChannelContent
-	(*JoinDirectContent)(nil),              // 146: JoinDirectContent
-	(*JoinGroupContent)(nil),               // 147: JoinGroupContent
-	(*PermitJoinGroupContent)(nil),         // 148: PermitJoinGroupContent
-	(*CancelJoinGroupContent)(nil),         // 149: CancelJoinGroupContent
-	(*LeaveJoinGroupContent)(nil),          // 150: LeaveJoinGroupContent
-	(*P2PGroupChangeContent)(nil),          // 151: P2PGroupChangeContent
-	(*BusinessContent)(nil),                // 152: BusinessContent
-	(EnvelopeBusinessType)(0),              // 153: EnvelopeBusinessType
-	(EnvelopeFollowShowStatus)(0),          // 154: EnvelopeFollowShowStatus
-	(*Ranking)(nil),                        // 155: Ranking
-	(*ValueLabel)(nil),                     // 156: ValueLabel
+	(*WebcastMessageCommon)(nil),                                // 3: WebcastMessageCommon
+	(*WebcastGiftMessage)(nil),                                  // 4: WebcastGiftMessage
+	(*RoomMessage)(nil),                                         // 5: RoomMessage
+	(*WebcastRoomMessage)(nil),                                  // 6: WebcastRoomMessage
+	(*WebcastBarrageMessage)(nil),                               // 7: WebcastBarrageMessage
+	(*WebcastCaptionMessage)(nil),                               // 8: WebcastCaptionMessage
+	(*WebcastChatMessage)(nil),                                  // 9: WebcastChatMessage
+	(*WebcastControlMessage)(nil),                               // 10: WebcastControlMessage
+	(*WebcastEmoteChatMessage)(nil),                             // 11: WebcastEmoteChatMessage
+	(*WebcastEnvelopeMessage)(nil),                              // 12: WebcastEnvelopeMessage
+	(*WebcastGoalUpdateMessage)(nil),                            // 13: WebcastGoalUpdateMessage
+	(*WebcastImDeleteMessage)(nil),                              // 14: WebcastImDeleteMessage
+	(*WebcastInRoomBannerMessage)(nil),                          // 15: WebcastInRoomBannerMessage
+	(*WebcastLikeMessage)(nil),                                  // 16: WebcastLikeMessage
+	(*WebcastRoomUserSeqMessage)(nil),                           // 17: WebcastRoomUserSeqMessage
+	(*WebcastSocialMessage)(nil),                                // 18: WebcastSocialMessage
+	(*WebcastSubNotifyMessage)(nil),                             // 19: WebcastSubNotifyMessage
+	(*WebcastRankUpdateMessage)(nil),                            // 20: WebcastRankUpdateMessage
+	(*WebcastMemberMessage)(nil),                                // 21: WebcastMemberMessage
+	(*WebcastPollMessage)(nil),                                  // 22: WebcastPollMessage
+	(*WebcastQuestionNewMessage)(nil),                           // 23: WebcastQuestionNewMessage
+	(*WebcastRankTextMessage)(nil),                              // 24: WebcastRankTextMessage
+	(*WebcastHourlyRankMessage)(nil),                            // 25: WebcastHourlyRankMessage
+	(*WebcastLinkMicArmies)(nil),                                // 26: WebcastLinkMicArmies
+	(*WebcastLinkMicBattlePunishFinish)(nil),                    // 27: WebcastLinkMicBattlePunishFinish
+	(*WebcastLinkmicBattleTaskMessage)(nil),                     // 28: WebcastLinkmicBattleTaskMessage
+	(*WebcastLinkMicBattle)(nil),                                // 29: WebcastLinkMicBattle
+	(*WebcastLinkMicFanTicketMethod)(nil),                       // 30: WebcastLinkMicFanTicketMethod
+	(*WebcastLinkMicMethod)(nil),                                // 31: WebcastLinkMicMethod
+	(*WebcastLiveIntroMessage)(nil),                             // 32: WebcastLiveIntroMessage
+	(*WebcastUnauthorizedMemberMessage)(nil),                    // 33: WebcastUnauthorizedMemberMessage
+	(*WebcastMsgDetectMessage)(nil),                             // 34: WebcastMsgDetectMessage
+	(*WebcastOecLiveShoppingMessage)(nil),                       // 35: WebcastOecLiveShoppingMessage
+	(*WebcastRoomPinMessage)(nil),                               // 36: WebcastRoomPinMessage
+	(*WebcastLiveGameIntroMessage)(nil),                         // 37: WebcastLiveGameIntroMessage
+	(*WebcastSystemMessage)(nil),                                // 38: WebcastSystemMessage
+	(*WebcastLinkMessage)(nil),                                  // 39: WebcastLinkMessage
+	(*WebcastLinkLayerMessage)(nil),                             // 40: WebcastLinkLayerMessage
+	(*RoomVerifyMessage)(nil),                                   // 41: RoomVerifyMessage
+	nil,                                                         // 42: WebcastPushFrame.HeadersEntry
+	nil,                                                         // 43: WebcastResponse.RouteParamsMapEntry
+	(*WebcastResponse_Message)(nil),                             // 44: WebcastResponse.Message
+	(*WebcastGiftMessage_UserGiftReciever)(nil),                 // 45: WebcastGiftMessage.UserGiftReciever
+	(*WebcastGiftMessage_GiftIMPriority)(nil),                   // 46: WebcastGiftMessage.GiftIMPriority
+	(*WebcastGiftMessage_PublicAreaCommon)(nil),                 // 47: WebcastGiftMessage.PublicAreaCommon
+	(*WebcastBarrageMessage_BarrageTypeUserGradeParam)(nil),     // 48: WebcastBarrageMessage.BarrageTypeUserGradeParam
+	(*WebcastBarrageMessage_BarrageTypeFansLevelParam)(nil),     // 49: WebcastBarrageMessage.BarrageTypeFansLevelParam
+	(*WebcastBarrageMessage_BarrageTypeSubscribeGiftParam)(nil), // 50: WebcastBarrageMessage.BarrageTypeSubscribeGiftParam
+	(*WebcastBarrageMessage_BarrageEvent)(nil),                  // 51: WebcastBarrageMessage.BarrageEvent
+	(*WebcastCaptionMessage_CaptionData)(nil),                   // 52: WebcastCaptionMessage.CaptionData
+	nil, // 53: WebcastChatMessage.CommentQualityScoresEntry
+	(*WebcastChatMessage_EmoteWithIndex)(nil),                                     // 54: WebcastChatMessage.EmoteWithIndex
+	(*WebcastControlMessage_Extra)(nil),                                           // 55: WebcastControlMessage.Extra
+	(*WebcastEnvelopeMessage_EnvelopeInfo)(nil),                                   // 56: WebcastEnvelopeMessage.EnvelopeInfo
+	(*WebcastRoomUserSeqMessage_Contributor)(nil),                                 // 57: WebcastRoomUserSeqMessage.Contributor
+	(*WebcastRankUpdateMessage_RankTabInfo)(nil),                                  // 58: WebcastRankUpdateMessage.RankTabInfo
+	(*WebcastRankUpdateMessage_RankUpdate)(nil),                                   // 59: WebcastRankUpdateMessage.RankUpdate
+	(*WebcastMemberMessage_EffectConfig)(nil),                                     // 60: WebcastMemberMessage.EffectConfig
+	(*WebcastQuestionNewMessage_QuestionDetails)(nil),                             // 61: WebcastQuestionNewMessage.QuestionDetails
+	(*WebcastHourlyRankMessage_RankContainer)(nil),                                // 62: WebcastHourlyRankMessage.RankContainer
+	(*WebcastHourlyRankMessage_RankContainer_RankingData)(nil),                    // 63: WebcastHourlyRankMessage.RankContainer.RankingData
+	(*WebcastHourlyRankMessage_RankContainer_RankingData2)(nil),                   // 64: WebcastHourlyRankMessage.RankContainer.RankingData2
+	(*WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData)(nil),        // 65: WebcastLinkMicBattlePunishFinish.LinkMicBattlePunishFinishData
+	(*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData)(nil),                 // 66: WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData
+	(*WebcastLinkmicBattleTaskMessage_BattleTaskData)(nil),                        // 67: WebcastLinkmicBattleTaskMessage.BattleTaskData
+	(*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2)(nil),                // 68: WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData2
+	(*WebcastLinkMicBattle_Host2V2Data)(nil),                                      // 69: WebcastLinkMicBattle.Host2v2Data
+	(*WebcastLinkMicBattle_LinkMicBattleConfig)(nil),                              // 70: WebcastLinkMicBattle.LinkMicBattleConfig
+	(*WebcastLinkMicBattle_LinkMicBattleTeamData)(nil),                            // 71: WebcastLinkMicBattle.LinkMicBattleTeamData
+	(*WebcastLinkMicBattle_LinkMicBattleData)(nil),                                // 72: WebcastLinkMicBattle.LinkMicBattleData
+	(*WebcastLinkMicBattle_LinkMicBattleDetails)(nil),                             // 73: WebcastLinkMicBattle.LinkMicBattleDetails
+	(*WebcastLinkMicBattle_LinkMicBattleTopViewers)(nil),                          // 74: WebcastLinkMicBattle.LinkMicBattleTopViewers
+	(*WebcastLinkMicBattle_LinkMicBattleHost)(nil),                                // 75: WebcastLinkMicBattle.LinkMicBattleHost
+	(*WebcastLinkMicBattle_Host2V2Data_HostData)(nil),                             // 76: WebcastLinkMicBattle.Host2v2Data.HostData
+	(*WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary)(nil), // 77: WebcastLinkMicBattle.LinkMicBattleDetails.LinkMicBattleDetailsSummary
+	(*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup)(nil),           // 78: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup
+	(*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer)(nil), // 79: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.TopViewer
+	(*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup)(nil),                      // 80: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup
+	(*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host)(nil),                 // 81: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.Host
+	(*WebcastMsgDetectMessage_TimeInfo)(nil),                                      // 82: WebcastMsgDetectMessage.TimeInfo
+	(*WebcastMsgDetectMessage_TriggerCondition)(nil),                              // 83: WebcastMsgDetectMessage.TriggerCondition
+	(*WebcastOecLiveShoppingMessage_LiveShoppingData)(nil),                        // 84: WebcastOecLiveShoppingMessage.LiveShoppingData
+	(*WebcastOecLiveShoppingMessage_LiveShoppingDetails)(nil),                     // 85: WebcastOecLiveShoppingMessage.LiveShoppingDetails
+	(*Common)(nil),                         // 86: Common
+	(*User)(nil),                           // 87: User
+	(*GiftStruct)(nil),                     // 88: GiftStruct
+	(*UserIdentity)(nil),                   // 89: UserIdentity
+	(*Image)(nil),                          // 90: Image
+	(*Text)(nil),                           // 91: Text
+	(ControlAction)(0),                     // 92: ControlAction
+	(*PunishEventInfo)(nil),                // 93: PunishEventInfo
+	(*Emote)(nil),                          // 94: Emote
+	(*MsgFilter)(nil),                      // 95: MsgFilter
+	(EnvelopeDisplay)(0),                   // 96: EnvelopeDisplay
+	(*Indicator)(nil),                      // 97: Indicator
+	(*Goal)(nil),                           // 98: Goal
+	(SubscribeType)(0),                     // 99: SubscribeType
+	(OldSubscribeStatus)(0),                // 100: OldSubscribeStatus
+	(SubscribingStatus)(0),                 // 101: SubscribingStatus
+	(MemberMessageAction)(0),               // 102: MemberMessageAction
+	(MessageType)(0),                       // 103: MessageType
+	(*PollStartContent)(nil),               // 104: PollStartContent
+	(*PollEndContent)(nil),                 // 105: PollEndContent
+	(*PollUpdateVotesContent)(nil),         // 106: PollUpdateVotesContent
+	(*LinkMicArmiesItems)(nil),             // 107: LinkMicArmiesItems
+	(LinkMicBattleStatus)(0),               // 108: LinkMicBattleStatus
+	(*FanTicketRoomNoticeContent)(nil),     // 109: FanTicketRoomNoticeContent
+	(AuditStatus)(0),                       // 110: AuditStatus
+	(*BadgeStruct)(nil),                    // 111: BadgeStruct
+	(*TimeStampContainer)(nil),             // 112: TimeStampContainer
+	(LinkMessageType)(0),                   // 113: LinkMessageType
+	(Scene)(0),                             // 114: Scene
+	(*LinkerInviteContent)(nil),            // 115: LinkerInviteContent
+	(*LinkerReplyContent)(nil),             // 116: LinkerReplyContent
+	(*LinkerCreateContent)(nil),            // 117: LinkerCreateContent
+	(*LinkerCloseContent)(nil),             // 118: LinkerCloseContent
+	(*LinkerEnterContent)(nil),             // 119: LinkerEnterContent
+	(*LinkerLeaveContent)(nil),             // 120: LinkerLeaveContent
+	(*LinkerCancelContent)(nil),            // 121: LinkerCancelContent
+	(*LinkerKickOutContent)(nil),           // 122: LinkerKickOutContent
+	(*LinkerLinkedListChangeContent)(nil),  // 123: LinkerLinkedListChangeContent
+	(*LinkerUpdateUserContent)(nil),        // 124: LinkerUpdateUserContent
+	(*LinkerWaitingListChangeContent)(nil), // 125: LinkerWaitingListChangeContent
+	(*LinkerMuteContent)(nil),              // 126: LinkerMuteContent
+	(*LinkerRandomMatchContent)(nil),       // 127: LinkerRandomMatchContent
+	(*LinkerUpdateUserSettingContent)(nil), // 128: LinkerUpdateUserSettingContent
+	(*LinkerMicIdxUpdateContent)(nil),      // 129: LinkerMicIdxUpdateContent
+	(*LinkerListChangeContent)(nil),        // 130: LinkerListChangeContent
+	(*CohostListChangeContent)(nil),        // 131: CohostListChangeContent
+	(*LinkerMediaChangeContent)(nil),       // 132: LinkerMediaChangeContent
+	(*LinkerAcceptNoticeContent)(nil),      // 133: LinkerAcceptNoticeContent
+	(*LinkerSysKickOutContent)(nil),        // 134: LinkerSysKickOutContent
+	(*LinkmicUserToastContent)(nil),        // 135: LinkmicUserToastContent
+	(*CreateChannelContent)(nil),           // 136: CreateChannelContent
+	(*ListChangeContent)(nil),              // 137: ListChangeContent
+	(*InviteContent)(nil),                  // 138: InviteContent
+	(*ApplyContent)(nil),                   // 139: ApplyContent
+	(*PermitApplyContent)(nil),             // 140: PermitApplyContent
+	(*ReplyInviteContent)(nil),             // 141: ReplyInviteContent
+	(*KickOutContent)(nil),                 // 142: KickOutContent
+	(*CancelApplyContent)(nil),             // 143: CancelApplyContent
+	(*CancelInviteContent)(nil),            // 144: CancelInviteContent
+	(*LeaveContent)(nil),                   // 145: LeaveContent
+	(*FinishChannelContent)(nil),           // 146: FinishChannelContent
+	(*JoinDirectContent)(nil),              // 147: JoinDirectContent
+	(*JoinGroupContent)(nil),               // 148: JoinGroupContent
+	(*PermitJoinGroupContent)(nil),         // 149: PermitJoinGroupContent
+	(*CancelJoinGroupContent)(nil),         // 150: CancelJoinGroupContent
+	(*LeaveJoinGroupContent)(nil),          // 151: LeaveJoinGroupContent
+	(*P2PGroupChangeContent)(nil),          // 152: P2PGroupChangeContent
+	(*BusinessContent)(nil),                // 153: BusinessContent
+	(EnvelopeBusinessType)(0),              // 154: EnvelopeBusinessType
+	(EnvelopeFollowShowStatus)(0),          // 155: EnvelopeFollowShowStatus
+	(*Ranking)(nil),                        // 156: Ranking
+	(*ValueLabel)(nil),                     // 157: ValueLabel
 }
 var file_webcast_proto_depIdxs = []int32{
-	41,  // 0: WebcastPushFrame.headers:type_name -> WebcastPushFrame.HeadersEntry
-	43,  // 1: WebcastResponse.messages:type_name -> WebcastResponse.Message
-	42,  // 2: WebcastResponse.routeParamsMap:type_name -> WebcastResponse.RouteParamsMapEntry
-	85,  // 3: WebcastGiftMessage.common:type_name -> Common
-	86,  // 4: WebcastGiftMessage.user:type_name -> User
-	86,  // 5: WebcastGiftMessage.toUser:type_name -> User
-	87,  // 6: WebcastGiftMessage.gift:type_name -> GiftStruct
-	88,  // 7: WebcastGiftMessage.userIdentity:type_name -> UserIdentity
-	44,  // 8: WebcastGiftMessage.userGiftReciever:type_name -> WebcastGiftMessage.UserGiftReciever
-	85,  // 9: RoomMessage.common:type_name -> Common
-	89,  // 10: RoomMessage.icon:type_name -> Image
-	85,  // 11: WebcastRoomMessage.common:type_name -> Common
-	85,  // 12: WebcastBarrageMessage.common:type_name -> Common
-	50,  // 13: WebcastBarrageMessage.event:type_name -> WebcastBarrageMessage.BarrageEvent
-	0,   // 14: WebcastBarrageMessage.msgType:type_name -> WebcastBarrageMessage.BarrageType
-	89,  // 15: WebcastBarrageMessage.icon:type_name -> Image
-	90,  // 16: WebcastBarrageMessage.content:type_name -> Text
-	89,  // 17: WebcastBarrageMessage.background:type_name -> Image
-	89,  // 18: WebcastBarrageMessage.rightIcon:type_name -> Image
-	47,  // 19: WebcastBarrageMessage.userGradeParam:type_name -> WebcastBarrageMessage.BarrageTypeUserGradeParam
-	48,  // 20: WebcastBarrageMessage.fansLevelParam:type_name -> WebcastBarrageMessage.BarrageTypeFansLevelParam
-	49,  // 21: WebcastBarrageMessage.subscribeGiftParam:type_name -> WebcastBarrageMessage.BarrageTypeSubscribeGiftParam
-	85,  // 22: WebcastCaptionMessage.common:type_name -> Common
-	51,  // 23: WebcastCaptionMessage.captionData:type_name -> WebcastCaptionMessage.CaptionData
-	85,  // 24: WebcastChatMessage.common:type_name -> Common
-	86,  // 25: WebcastChatMessage.user:type_name -> User
-	89,  // 26: WebcastChatMessage.backgroundImage:type_name -> Image
-	89,  // 27: WebcastChatMessage.backgroundImageV2:type_name -> Image
-	89,  // 28: WebcastChatMessage.giftImage:type_name -> Image
-	86,  // 29: WebcastChatMessage.atUser:type_name -> User
-	53,  // 30: WebcastChatMessage.emotesList:type_name -> WebcastChatMessage.EmoteWithIndex
-	88,  // 31: WebcastChatMessage.UserIdentity:type_name -> UserIdentity
-	52,  // 32: WebcastChatMessage.CommentQualityScores:type_name -> WebcastChatMessage.CommentQualityScoresEntry
-	85,  // 33: WebcastControlMessage.common:type_name -> Common
-	91,  // 34: WebcastControlMessage.action:type_name -> ControlAction
-	54,  // 35: WebcastControlMessage.extra:type_name -> WebcastControlMessage.Extra
-	90,  // 36: WebcastControlMessage.perceptionAudienceText:type_name -> Text
-	92,  // 37: WebcastControlMessage.punishInfo:type_name -> PunishEventInfo
-	90,  // 38: WebcastControlMessage.floatText:type_name -> Text
-	85,  // 39: WebcastEmoteChatMessage.common:type_name -> Common
-	86,  // 40: WebcastEmoteChatMessage.user:type_name -> User
-	93,  // 41: WebcastEmoteChatMessage.emoteList:type_name -> Emote
-	94,  // 42: WebcastEmoteChatMessage.msgFilter:type_name -> MsgFilter
-	88,  // 43: WebcastEmoteChatMessage.userIdentity:type_name -> UserIdentity
-	85,  // 44: WebcastEnvelopeMessage.common:type_name -> Common
-	55,  // 45: WebcastEnvelopeMessage.envelopeInfo:type_name -> WebcastEnvelopeMessage.EnvelopeInfo
-	95,  // 46: WebcastEnvelopeMessage.display:type_name -> EnvelopeDisplay
-	85,  // 47: WebcastGoalUpdateMessage.common:type_name -> Common
-	96,  // 48: WebcastGoalUpdateMessage.indicator:type_name -> Indicator
-	97,  // 49: WebcastGoalUpdateMessage.goal:type_name -> Goal
-	89,  // 50: WebcastGoalUpdateMessage.contributorAvatar:type_name -> Image
-	85,  // 51: WebcastImDeleteMessage.common:type_name -> Common
-	85,  // 52: WebcastInRoomBannerMessage.header:type_name -> Common
-	85,  // 53: WebcastLikeMessage.common:type_name -> Common
-	86,  // 54: WebcastLikeMessage.user:type_name -> User
-	85,  // 55: WebcastRoomUserSeqMessage.common:type_name -> Common
-	56,  // 56: WebcastRoomUserSeqMessage.ranksList:type_name -> WebcastRoomUserSeqMessage.Contributor
-	56,  // 57: WebcastRoomUserSeqMessage.seatsList:type_name -> WebcastRoomUserSeqMessage.Contributor
-	85,  // 58: WebcastSocialMessage.common:type_name -> Common
-	86,  // 59: WebcastSocialMessage.user:type_name -> User
-	85,  // 60: WebcastSubNotifyMessage.common:type_name -> Common
-	86,  // 61: WebcastSubNotifyMessage.user:type_name -> User
-	98,  // 62: WebcastSubNotifyMessage.subscribeType:type_name -> SubscribeType
-	99,  // 63: WebcastSubNotifyMessage.oldSubscribeStatus:type_name -> OldSubscribeStatus
-	100, // 64: WebcastSubNotifyMessage.subscribingStatus:type_name -> SubscribingStatus
-	85,  // 65: WebcastRankUpdateMessage.common:type_name -> Common
-	58,  // 66: WebcastRankUpdateMessage.updatesList:type_name -> WebcastRankUpdateMessage.RankUpdate
-	57,  // 67: WebcastRankUpdateMessage.tabsList:type_name -> WebcastRankUpdateMessage.RankTabInfo
-	85,  // 68: WebcastMemberMessage.common:type_name -> Common
-	86,  // 69: WebcastMemberMessage.user:type_name -> User
-	86,  // 70: WebcastMemberMessage.operator:type_name -> User
-	101, // 71: WebcastMemberMessage.action:type_name -> MemberMessageAction
-	59,  // 72: WebcastMemberMessage.effectConfig:type_name -> WebcastMemberMessage.EffectConfig
-	59,  // 73: WebcastMemberMessage.enterEffectConfig:type_name -> WebcastMemberMessage.EffectConfig
-	89,  // 74: WebcastMemberMessage.backgroundImage:type_name -> Image
-	89,  // 75: WebcastMemberMessage.backgroundImageV2:type_name -> Image
-	90,  // 76: WebcastMemberMessage.anchorDisplayText:type_name -> Text
-	85,  // 77: WebcastPollMessage.common:type_name -> Common
-	102, // 78: WebcastPollMessage.messageType:type_name -> MessageType
-	103, // 79: WebcastPollMessage.startContent:type_name -> PollStartContent
-	104, // 80: WebcastPollMessage.endContent:type_name -> PollEndContent
-	105, // 81: WebcastPollMessage.updateContent:type_name -> PollUpdateVotesContent
-	85,  // 82: WebcastQuestionNewMessage.common:type_name -> Common
-	60,  // 83: WebcastQuestionNewMessage.details:type_name -> WebcastQuestionNewMessage.QuestionDetails
-	85,  // 84: WebcastRankTextMessage.common:type_name -> Common
-	90,  // 85: WebcastRankTextMessage.selfGetBadgeMsg:type_name -> Text
-	90,  // 86: WebcastRankTextMessage.otherGetBadgeMsg:type_name -> Text
-	85,  // 87: WebcastHourlyRankMessage.common:type_name -> Common
-	61,  // 88: WebcastHourlyRankMessage.data:type_name -> WebcastHourlyRankMessage.RankContainer
-	85,  // 89: WebcastLinkMicArmies.common:type_name -> Common
-	106, // 90: WebcastLinkMicArmies.battleItems:type_name -> LinkMicArmiesItems
-	107, // 91: WebcastLinkMicArmies.battleStatus:type_name -> LinkMicBattleStatus
-	89,  // 92: WebcastLinkMicArmies.Image:type_name -> Image
-	85,  // 93: WebcastLinkMicBattlePunishFinish.Header:type_name -> Common
-	64,  // 94: WebcastLinkMicBattlePunishFinish.Data6:type_name -> WebcastLinkMicBattlePunishFinish.LinkMicBattlePunishFinishData
-	85,  // 95: WebcastLinkmicBattleTaskMessage.Header:type_name -> Common
-	65,  // 96: WebcastLinkmicBattleTaskMessage.Data3:type_name -> WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData
-	67,  // 97: WebcastLinkmicBattleTaskMessage.Data5:type_name -> WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData2
-	85,  // 98: WebcastLinkMicBattle.common:type_name -> Common
-	69,  // 99: WebcastLinkMicBattle.battleConfig:type_name -> WebcastLinkMicBattle.LinkMicBattleConfig
-	107, // 100: WebcastLinkMicBattle.battleStatus:type_name -> LinkMicBattleStatus
-	72,  // 101: WebcastLinkMicBattle.details:type_name -> WebcastLinkMicBattle.LinkMicBattleDetails
-	73,  // 102: WebcastLinkMicBattle.viewerTeam:type_name -> WebcastLinkMicBattle.LinkMicBattleTopViewers
-	74,  // 103: WebcastLinkMicBattle.hostTeam:type_name -> WebcastLinkMicBattle.LinkMicBattleHost
-	70,  // 104: WebcastLinkMicBattle.teamData:type_name -> WebcastLinkMicBattle.LinkMicBattleTeamData
-	68,  // 105: WebcastLinkMicBattle.hostData2v2:type_name -> WebcastLinkMicBattle.Host2v2Data
-	85,  // 106: WebcastLinkMicFanTicketMethod.common:type_name -> Common
-	108, // 107: WebcastLinkMicFanTicketMethod.FanTicketRoomNotice:type_name -> FanTicketRoomNoticeContent
-	85,  // 108: WebcastLinkMicMethod.common:type_name -> Common
-	102, // 109: WebcastLinkMicMethod.messageType:type_name -> MessageType
-	85,  // 110: WebcastLiveIntroMessage.common:type_name -> Common
-	109, // 111: WebcastLiveIntroMessage.auditStatus:type_name -> AuditStatus
-	86,  // 112: WebcastLiveIntroMessage.host:type_name -> User
-	110, // 113: WebcastLiveIntroMessage.badges:type_name -> BadgeStruct
-	85,  // 114: WebcastUnauthorizedMemberMessage.common:type_name -> Common
-	90,  // 115: WebcastUnauthorizedMemberMessage.nickNamePrefix:type_name -> Text
-	90,  // 116: WebcastUnauthorizedMemberMessage.enterText:type_name -> Text
-	85,  // 117: WebcastMsgDetectMessage.common:type_name -> Common
-	82,  // 118: WebcastMsgDetectMessage.triggerCondition:type_name -> WebcastMsgDetectMessage.TriggerCondition
-	81,  // 119: WebcastMsgDetectMessage.timeInfo:type_name -> WebcastMsgDetectMessage.TimeInfo
-	85,  // 120: WebcastOecLiveShoppingMessage.common:type_name -> Common
-	83,  // 121: WebcastOecLiveShoppingMessage.shopData:type_name -> WebcastOecLiveShoppingMessage.LiveShoppingData
-	111, // 122: WebcastOecLiveShoppingMessage.shopTimings:type_name -> TimeStampContainer
-	84,  // 123: WebcastOecLiveShoppingMessage.details:type_name -> WebcastOecLiveShoppingMessage.LiveShoppingDetails
-	85,  // 124: WebcastRoomPinMessage.common:type_name -> Common
-	85,  // 125: WebcastLiveGameIntroMessage.common:type_name -> Common
-	90,  // 126: WebcastLiveGameIntroMessage.gameText:type_name -> Text
-	85,  // 127: WebcastSystemMessage.common:type_name -> Common
-	85,  // 128: WebcastLinkMessage.common:type_name -> Common
-	112, // 129: WebcastLinkMessage.MessageType:type_name -> LinkMessageType
-	113, // 130: WebcastLinkMessage.Scene:type_name -> Scene
-	114, // 131: WebcastLinkMessage.InviteContent:type_name -> LinkerInviteContent
-	115, // 132: WebcastLinkMessage.ReplyContent:type_name -> LinkerReplyContent
-	116, // 133: WebcastLinkMessage.CreateContent:type_name -> LinkerCreateContent
-	117, // 134: WebcastLinkMessage.CloseContent:type_name -> LinkerCloseContent
-	118, // 135: WebcastLinkMessage.EnterContent:type_name -> LinkerEnterContent
-	119, // 136: WebcastLinkMessage.LeaveContent:type_name -> LinkerLeaveContent
-	120, // 137: WebcastLinkMessage.CancelContent:type_name -> LinkerCancelContent
-	121, // 138: WebcastLinkMessage.KickOutContent:type_name -> LinkerKickOutContent
-	122, // 139: WebcastLinkMessage.LinkedListChangeContent:type_name -> LinkerLinkedListChangeContent
-	123, // 140: WebcastLinkMessage.UpdateUserContent:type_name -> LinkerUpdateUserContent
-	124, // 141: WebcastLinkMessage.WaitingListChangeContent:type_name -> LinkerWaitingListChangeContent
-	125, // 142: WebcastLinkMessage.MuteContent:type_name -> LinkerMuteContent
-	126, // 143: WebcastLinkMessage.RandomMatchContent:type_name -> LinkerRandomMatchContent
-	127, // 144: WebcastLinkMessage.UpdateUserSettingContent:type_name -> LinkerUpdateUserSettingContent
-	128, // 145: WebcastLinkMessage.MicIdxUpdateContent:type_name -> LinkerMicIdxUpdateContent
-	129, // 146: WebcastLinkMessage.ListChangeContent:type_name -> LinkerListChangeContent
-	130, // 147: WebcastLinkMessage.CohostListChangeContent:type_name -> CohostListChangeContent
-	131, // 148: WebcastLinkMessage.MediaChangeContent:type_name -> LinkerMediaChangeContent
-	132, // 149: WebcastLinkMessage.AcceptNoticeContent:type_name -> LinkerAcceptNoticeContent
-	133, // 150: WebcastLinkMessage.SysKickOutContent:type_name -> LinkerSysKickOutContent
-	134, // 151: WebcastLinkMessage.UserToastContent:type_name -> LinkmicUserToastContent
-	85,  // 152: WebcastLinkLayerMessage.common:type_name -> Common
-	102, // 153: WebcastLinkLayerMessage.messageType:type_name -> MessageType
-	113, // 154: WebcastLinkLayerMessage.scene:type_name -> Scene
-	135, // 155: WebcastLinkLayerMessage.createChannelContent:type_name -> CreateChannelContent
-	136, // 156: WebcastLinkLayerMessage.listChangeContent:type_name -> ListChangeContent
-	137, // 157: WebcastLinkLayerMessage.inviteContent:type_name -> InviteContent
-	138, // 158: WebcastLinkLayerMessage.applyContent:type_name -> ApplyContent
-	139, // 159: WebcastLinkLayerMessage.permitApplyContent:type_name -> PermitApplyContent
-	140, // 160: WebcastLinkLayerMessage.replyInviteContent:type_name -> ReplyInviteContent
-	141, // 161: WebcastLinkLayerMessage.kickOutContent:type_name -> KickOutContent
-	142, // 162: WebcastLinkLayerMessage.cancelApplyContent:type_name -> CancelApplyContent
-	143, // 163: WebcastLinkLayerMessage.cancelInviteContent:type_name -> CancelInviteContent
-	144, // 164: WebcastLinkLayerMessage.leaveContent:type_name -> LeaveContent
-	145, // 165: WebcastLinkLayerMessage.finishContent:type_name -> FinishChannelContent
-	146, // 166: WebcastLinkLayerMessage.joinDirectContent:type_name -> JoinDirectContent
-	147, // 167: WebcastLinkLayerMessage.joinGroupContent:type_name -> JoinGroupContent
-	148, // 168: WebcastLinkLayerMessage.permitGroupContent:type_name -> PermitJoinGroupContent
-	149, // 169: WebcastLinkLayerMessage.cancelGroupContent:type_name -> CancelJoinGroupContent
-	150, // 170: WebcastLinkLayerMessage.leaveGroupContent:type_name -> LeaveJoinGroupContent
-	151, // 171: WebcastLinkLayerMessage.p2pGroupChangeContent:type_name -> P2PGroupChangeContent
-	152, // 172: WebcastLinkLayerMessage.businessContent:type_name -> BusinessContent
-	85,  // 173: RoomVerifyMessage.common:type_name -> Common
-	89,  // 174: WebcastGiftMessage.PublicAreaCommon.userLabel:type_name -> Image
-	86,  // 175: WebcastBarrageMessage.BarrageTypeUserGradeParam.user:type_name -> User
-	86,  // 176: WebcastBarrageMessage.BarrageTypeFansLevelParam.user:type_name -> User
-	93,  // 177: WebcastChatMessage.EmoteWithIndex.emote:type_name -> Emote
-	90,  // 178: WebcastControlMessage.Extra.title:type_name -> Text
-	90,  // 179: WebcastControlMessage.Extra.violationReason:type_name -> Text
-	90,  // 180: WebcastControlMessage.Extra.content:type_name -> Text
-	90,  // 181: WebcastControlMessage.Extra.gotItButton:type_name -> Text
-	90,  // 182: WebcastControlMessage.Extra.banDetailButton:type_name -> Text
-	153, // 183: WebcastEnvelopeMessage.EnvelopeInfo.businessType:type_name -> EnvelopeBusinessType
-	89,  // 184: WebcastEnvelopeMessage.EnvelopeInfo.sendUserAvatar:type_name -> Image
-	154, // 185: WebcastEnvelopeMessage.EnvelopeInfo.followShowStatus:type_name -> EnvelopeFollowShowStatus
-	86,  // 186: WebcastRoomUserSeqMessage.Contributor.user:type_name -> User
-	90,  // 187: WebcastRankUpdateMessage.RankTabInfo.titleText:type_name -> Text
-	90,  // 188: WebcastRankUpdateMessage.RankUpdate.defaultContent:type_name -> Text
-	89,  // 189: WebcastMemberMessage.EffectConfig.icon:type_name -> Image
-	90,  // 190: WebcastMemberMessage.EffectConfig.text:type_name -> Text
-	89,  // 191: WebcastMemberMessage.EffectConfig.textIcon:type_name -> Image
-	89,  // 192: WebcastMemberMessage.EffectConfig.badge:type_name -> Image
-	86,  // 193: WebcastQuestionNewMessage.QuestionDetails.user:type_name -> User
-	62,  // 194: WebcastHourlyRankMessage.RankContainer.rankingdata:type_name -> WebcastHourlyRankMessage.RankContainer.RankingData
-	155, // 195: WebcastHourlyRankMessage.RankContainer.rankings:type_name -> Ranking
-	63,  // 196: WebcastHourlyRankMessage.RankContainer.rankingdata2:type_name -> WebcastHourlyRankMessage.RankContainer.RankingData2
-	155, // 197: WebcastHourlyRankMessage.RankContainer.RankingData.rankdata:type_name -> Ranking
-	155, // 198: WebcastHourlyRankMessage.RankContainer.RankingData2.rankdata:type_name -> Ranking
-	66,  // 199: WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData.Data1:type_name -> WebcastLinkmicBattleTaskMessage.BattleTaskData
-	75,  // 200: WebcastLinkMicBattle.Host2v2Data.hostdata:type_name -> WebcastLinkMicBattle.Host2v2Data.HostData
-	71,  // 201: WebcastLinkMicBattle.LinkMicBattleTeamData.data:type_name -> WebcastLinkMicBattle.LinkMicBattleData
-	76,  // 202: WebcastLinkMicBattle.LinkMicBattleDetails.summary:type_name -> WebcastLinkMicBattle.LinkMicBattleDetails.LinkMicBattleDetailsSummary
-	77,  // 203: WebcastLinkMicBattle.LinkMicBattleTopViewers.viewerGroup:type_name -> WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup
-	79,  // 204: WebcastLinkMicBattle.LinkMicBattleHost.hostGroup:type_name -> WebcastLinkMicBattle.LinkMicBattleHost.HostGroup
-	78,  // 205: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.viewer:type_name -> WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.TopViewer
-	89,  // 206: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.TopViewer.images:type_name -> Image
-	80,  // 207: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.host:type_name -> WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.Host
-	89,  // 208: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.Host.images:type_name -> Image
-	156, // 209: WebcastOecLiveShoppingMessage.LiveShoppingDetails.data:type_name -> ValueLabel
-	210, // [210:210] is the sub-list for method output_type
-	210, // [210:210] is the sub-list for method input_type
-	210, // [210:210] is the sub-list for extension type_name
-	210, // [210:210] is the sub-list for extension extendee
-	0,   // [0:210] is the sub-list for field type_name
+	42,  // 0: WebcastPushFrame.headers:type_name -> WebcastPushFrame.HeadersEntry
+	44,  // 1: WebcastResponse.messages:type_name -> WebcastResponse.Message
+	43,  // 2: WebcastResponse.routeParamsMap:type_name -> WebcastResponse.RouteParamsMapEntry
+	86,  // 3: WebcastMessageCommon.common:type_name -> Common
+	86,  // 4: WebcastGiftMessage.common:type_name -> Common
+	87,  // 5: WebcastGiftMessage.user:type_name -> User
+	87,  // 6: WebcastGiftMessage.toUser:type_name -> User
+	88,  // 7: WebcastGiftMessage.gift:type_name -> GiftStruct
+	89,  // 8: WebcastGiftMessage.userIdentity:type_name -> UserIdentity
+	45,  // 9: WebcastGiftMessage.userGiftReciever:type_name -> WebcastGiftMessage.UserGiftReciever
+	86,  // 10: RoomMessage.common:type_name -> Common
+	90,  // 11: RoomMessage.icon:type_name -> Image
+	86,  // 12: WebcastRoomMessage.common:type_name -> Common
+	86,  // 13: WebcastBarrageMessage.common:type_name -> Common
+	51,  // 14: WebcastBarrageMessage.event:type_name -> WebcastBarrageMessage.BarrageEvent
+	0,   // 15: WebcastBarrageMessage.msgType:type_name -> WebcastBarrageMessage.BarrageType
+	90,  // 16: WebcastBarrageMessage.icon:type_name -> Image
+	91,  // 17: WebcastBarrageMessage.content:type_name -> Text
+	90,  // 18: WebcastBarrageMessage.background:type_name -> Image
+	90,  // 19: WebcastBarrageMessage.rightIcon:type_name -> Image
+	48,  // 20: WebcastBarrageMessage.userGradeParam:type_name -> WebcastBarrageMessage.BarrageTypeUserGradeParam
+	49,  // 21: WebcastBarrageMessage.fansLevelParam:type_name -> WebcastBarrageMessage.BarrageTypeFansLevelParam
+	50,  // 22: WebcastBarrageMessage.subscribeGiftParam:type_name -> WebcastBarrageMessage.BarrageTypeSubscribeGiftParam
+	86,  // 23: WebcastCaptionMessage.common:type_name -> Common
+	52,  // 24: WebcastCaptionMessage.captionData:type_name -> WebcastCaptionMessage.CaptionData
+	86,  // 25: WebcastChatMessage.common:type_name -> Common
+	87,  // 26: WebcastChatMessage.user:type_name -> User
+	90,  // 27: WebcastChatMessage.backgroundImage:type_name -> Image
+	90,  // 28: WebcastChatMessage.backgroundImageV2:type_name -> Image
+	90,  // 29: WebcastChatMessage.giftImage:type_name -> Image
+	87,  // 30: WebcastChatMessage.atUser:type_name -> User
+	54,  // 31: WebcastChatMessage.emotesList:type_name -> WebcastChatMessage.EmoteWithIndex
+	89,  // 32: WebcastChatMessage.UserIdentity:type_name -> UserIdentity
+	53,  // 33: WebcastChatMessage.CommentQualityScores:type_name -> WebcastChatMessage.CommentQualityScoresEntry
+	86,  // 34: WebcastControlMessage.common:type_name -> Common
+	92,  // 35: WebcastControlMessage.action:type_name -> ControlAction
+	55,  // 36: WebcastControlMessage.extra:type_name -> WebcastControlMessage.Extra
+	91,  // 37: WebcastControlMessage.perceptionAudienceText:type_name -> Text
+	93,  // 38: WebcastControlMessage.punishInfo:type_name -> PunishEventInfo
+	91,  // 39: WebcastControlMessage.floatText:type_name -> Text
+	86,  // 40: WebcastEmoteChatMessage.common:type_name -> Common
+	87,  // 41: WebcastEmoteChatMessage.user:type_name -> User
+	94,  // 42: WebcastEmoteChatMessage.emoteList:type_name -> Emote
+	95,  // 43: WebcastEmoteChatMessage.msgFilter:type_name -> MsgFilter
+	89,  // 44: WebcastEmoteChatMessage.userIdentity:type_name -> UserIdentity
+	86,  // 45: WebcastEnvelopeMessage.common:type_name -> Common
+	56,  // 46: WebcastEnvelopeMessage.envelopeInfo:type_name -> WebcastEnvelopeMessage.EnvelopeInfo
+	96,  // 47: WebcastEnvelopeMessage.display:type_name -> EnvelopeDisplay
+	86,  // 48: WebcastGoalUpdateMessage.common:type_name -> Common
+	97,  // 49: WebcastGoalUpdateMessage.indicator:type_name -> Indicator
+	98,  // 50: WebcastGoalUpdateMessage.goal:type_name -> Goal
+	90,  // 51: WebcastGoalUpdateMessage.contributorAvatar:type_name -> Image
+	86,  // 52: WebcastImDeleteMessage.common:type_name -> Common
+	86,  // 53: WebcastInRoomBannerMessage.header:type_name -> Common
+	86,  // 54: WebcastLikeMessage.common:type_name -> Common
+	87,  // 55: WebcastLikeMessage.user:type_name -> User
+	86,  // 56: WebcastRoomUserSeqMessage.common:type_name -> Common
+	57,  // 57: WebcastRoomUserSeqMessage.ranksList:type_name -> WebcastRoomUserSeqMessage.Contributor
+	57,  // 58: WebcastRoomUserSeqMessage.seatsList:type_name -> WebcastRoomUserSeqMessage.Contributor
+	86,  // 59: WebcastSocialMessage.common:type_name -> Common
+	87,  // 60: WebcastSocialMessage.user:type_name -> User
+	86,  // 61: WebcastSubNotifyMessage.common:type_name -> Common
+	87,  // 62: WebcastSubNotifyMessage.user:type_name -> User
+	99,  // 63: WebcastSubNotifyMessage.subscribeType:type_name -> SubscribeType
+	100, // 64: WebcastSubNotifyMessage.oldSubscribeStatus:type_name -> OldSubscribeStatus
+	101, // 65: WebcastSubNotifyMessage.subscribingStatus:type_name -> SubscribingStatus
+	86,  // 66: WebcastRankUpdateMessage.common:type_name -> Common
+	59,  // 67: WebcastRankUpdateMessage.updatesList:type_name -> WebcastRankUpdateMessage.RankUpdate
+	58,  // 68: WebcastRankUpdateMessage.tabsList:type_name -> WebcastRankUpdateMessage.RankTabInfo
+	86,  // 69: WebcastMemberMessage.common:type_name -> Common
+	87,  // 70: WebcastMemberMessage.user:type_name -> User
+	87,  // 71: WebcastMemberMessage.operator:type_name -> User
+	102, // 72: WebcastMemberMessage.action:type_name -> MemberMessageAction
+	60,  // 73: WebcastMemberMessage.effectConfig:type_name -> WebcastMemberMessage.EffectConfig
+	60,  // 74: WebcastMemberMessage.enterEffectConfig:type_name -> WebcastMemberMessage.EffectConfig
+	90,  // 75: WebcastMemberMessage.backgroundImage:type_name -> Image
+	90,  // 76: WebcastMemberMessage.backgroundImageV2:type_name -> Image
+	91,  // 77: WebcastMemberMessage.anchorDisplayText:type_name -> Text
+	86,  // 78: WebcastPollMessage.common:type_name -> Common
+	103, // 79: WebcastPollMessage.messageType:type_name -> MessageType
+	104, // 80: WebcastPollMessage.startContent:type_name -> PollStartContent
+	105, // 81: WebcastPollMessage.endContent:type_name -> PollEndContent
+	106, // 82: WebcastPollMessage.updateContent:type_name -> PollUpdateVotesContent
+	86,  // 83: WebcastQuestionNewMessage.common:type_name -> Common
+	61,  // 84: WebcastQuestionNewMessage.details:type_name -> WebcastQuestionNewMessage.QuestionDetails
+	86,  // 85: WebcastRankTextMessage.common:type_name -> Common
+	91,  // 86: WebcastRankTextMessage.selfGetBadgeMsg:type_name -> Text
+	91,  // 87: WebcastRankTextMessage.otherGetBadgeMsg:type_name -> Text
+	86,  // 88: WebcastHourlyRankMessage.common:type_name -> Common
+	62,  // 89: WebcastHourlyRankMessage.data:type_name -> WebcastHourlyRankMessage.RankContainer
+	86,  // 90: WebcastLinkMicArmies.common:type_name -> Common
+	107, // 91: WebcastLinkMicArmies.battleItems:type_name -> LinkMicArmiesItems
+	108, // 92: WebcastLinkMicArmies.battleStatus:type_name -> LinkMicBattleStatus
+	90,  // 93: WebcastLinkMicArmies.Image:type_name -> Image
+	86,  // 94: WebcastLinkMicBattlePunishFinish.Header:type_name -> Common
+	65,  // 95: WebcastLinkMicBattlePunishFinish.Data6:type_name -> WebcastLinkMicBattlePunishFinish.LinkMicBattlePunishFinishData
+	86,  // 96: WebcastLinkmicBattleTaskMessage.Header:type_name -> Common
+	66,  // 97: WebcastLinkmicBattleTaskMessage.Data3:type_name -> WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData
+	68,  // 98: WebcastLinkmicBattleTaskMessage.Data5:type_name -> WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData2
+	86,  // 99: WebcastLinkMicBattle.common:type_name -> Common
+	70,  // 100: WebcastLinkMicBattle.battleConfig:type_name -> WebcastLinkMicBattle.LinkMicBattleConfig
+	108, // 101: WebcastLinkMicBattle.battleStatus:type_name -> LinkMicBattleStatus
+	73,  // 102: WebcastLinkMicBattle.details:type_name -> WebcastLinkMicBattle.LinkMicBattleDetails
+	74,  // 103: WebcastLinkMicBattle.viewerTeam:type_name -> WebcastLinkMicBattle.LinkMicBattleTopViewers
+	75,  // 104: WebcastLinkMicBattle.hostTeam:type_name -> WebcastLinkMicBattle.LinkMicBattleHost
+	71,  // 105: WebcastLinkMicBattle.teamData:type_name -> WebcastLinkMicBattle.LinkMicBattleTeamData
+	69,  // 106: WebcastLinkMicBattle.hostData2v2:type_name -> WebcastLinkMicBattle.Host2v2Data
+	86,  // 107: WebcastLinkMicFanTicketMethod.common:type_name -> Common
+	109, // 108: WebcastLinkMicFanTicketMethod.FanTicketRoomNotice:type_name -> FanTicketRoomNoticeContent
+	86,  // 109: WebcastLinkMicMethod.common:type_name -> Common
+	103, // 110: WebcastLinkMicMethod.messageType:type_name -> MessageType
+	86,  // 111: WebcastLiveIntroMessage.common:type_name -> Common
+	110, // 112: WebcastLiveIntroMessage.auditStatus:type_name -> AuditStatus
+	87,  // 113: WebcastLiveIntroMessage.host:type_name -> User
+	111, // 114: WebcastLiveIntroMessage.badges:type_name -> BadgeStruct
+	86,  // 115: WebcastUnauthorizedMemberMessage.common:type_name -> Common
+	91,  // 116: WebcastUnauthorizedMemberMessage.nickNamePrefix:type_name -> Text
+	91,  // 117: WebcastUnauthorizedMemberMessage.enterText:type_name -> Text
+	86,  // 118: WebcastMsgDetectMessage.common:type_name -> Common
+	83,  // 119: WebcastMsgDetectMessage.triggerCondition:type_name -> WebcastMsgDetectMessage.TriggerCondition
+	82,  // 120: WebcastMsgDetectMessage.timeInfo:type_name -> WebcastMsgDetectMessage.TimeInfo
+	86,  // 121: WebcastOecLiveShoppingMessage.common:type_name -> Common
+	84,  // 122: WebcastOecLiveShoppingMessage.shopData:type_name -> WebcastOecLiveShoppingMessage.LiveShoppingData
+	112, // 123: WebcastOecLiveShoppingMessage.shopTimings:type_name -> TimeStampContainer
+	85,  // 124: WebcastOecLiveShoppingMessage.details:type_name -> WebcastOecLiveShoppingMessage.LiveShoppingDetails
+	86,  // 125: WebcastRoomPinMessage.common:type_name -> Common
+	86,  // 126: WebcastLiveGameIntroMessage.common:type_name -> Common
+	91,  // 127: WebcastLiveGameIntroMessage.gameText:type_name -> Text
+	86,  // 128: WebcastSystemMessage.common:type_name -> Common
+	86,  // 129: WebcastLinkMessage.common:type_name -> Common
+	113, // 130: WebcastLinkMessage.MessageType:type_name -> LinkMessageType
+	114, // 131: WebcastLinkMessage.Scene:type_name -> Scene
+	115, // 132: WebcastLinkMessage.InviteContent:type_name -> LinkerInviteContent
+	116, // 133: WebcastLinkMessage.ReplyContent:type_name -> LinkerReplyContent
+	117, // 134: WebcastLinkMessage.CreateContent:type_name -> LinkerCreateContent
+	118, // 135: WebcastLinkMessage.CloseContent:type_name -> LinkerCloseContent
+	119, // 136: WebcastLinkMessage.EnterContent:type_name -> LinkerEnterContent
+	120, // 137: WebcastLinkMessage.LeaveContent:type_name -> LinkerLeaveContent
+	121, // 138: WebcastLinkMessage.CancelContent:type_name -> LinkerCancelContent
+	122, // 139: WebcastLinkMessage.KickOutContent:type_name -> LinkerKickOutContent
+	123, // 140: WebcastLinkMessage.LinkedListChangeContent:type_name -> LinkerLinkedListChangeContent
+	124, // 141: WebcastLinkMessage.UpdateUserContent:type_name -> LinkerUpdateUserContent
+	125, // 142: WebcastLinkMessage.WaitingListChangeContent:type_name -> LinkerWaitingListChangeContent
+	126, // 143: WebcastLinkMessage.MuteContent:type_name -> LinkerMuteContent
+	127, // 144: WebcastLinkMessage.RandomMatchContent:type_name -> LinkerRandomMatchContent
+	128, // 145: WebcastLinkMessage.UpdateUserSettingContent:type_name -> LinkerUpdateUserSettingContent
+	129, // 146: WebcastLinkMessage.MicIdxUpdateContent:type_name -> LinkerMicIdxUpdateContent
+	130, // 147: WebcastLinkMessage.ListChangeContent:type_name -> LinkerListChangeContent
+	131, // 148: WebcastLinkMessage.CohostListChangeContent:type_name -> CohostListChangeContent
+	132, // 149: WebcastLinkMessage.MediaChangeContent:type_name -> LinkerMediaChangeContent
+	133, // 150: WebcastLinkMessage.AcceptNoticeContent:type_name -> LinkerAcceptNoticeContent
+	134, // 151: WebcastLinkMessage.SysKickOutContent:type_name -> LinkerSysKickOutContent
+	135, // 152: WebcastLinkMessage.UserToastContent:type_name -> LinkmicUserToastContent
+	86,  // 153: WebcastLinkLayerMessage.common:type_name -> Common
+	103, // 154: WebcastLinkLayerMessage.messageType:type_name -> MessageType
+	114, // 155: WebcastLinkLayerMessage.scene:type_name -> Scene
+	136, // 156: WebcastLinkLayerMessage.createChannelContent:type_name -> CreateChannelContent
+	137, // 157: WebcastLinkLayerMessage.listChangeContent:type_name -> ListChangeContent
+	138, // 158: WebcastLinkLayerMessage.inviteContent:type_name -> InviteContent
+	139, // 159: WebcastLinkLayerMessage.applyContent:type_name -> ApplyContent
+	140, // 160: WebcastLinkLayerMessage.permitApplyContent:type_name -> PermitApplyContent
+	141, // 161: WebcastLinkLayerMessage.replyInviteContent:type_name -> ReplyInviteContent
+	142, // 162: WebcastLinkLayerMessage.kickOutContent:type_name -> KickOutContent
+	143, // 163: WebcastLinkLayerMessage.cancelApplyContent:type_name -> CancelApplyContent
+	144, // 164: WebcastLinkLayerMessage.cancelInviteContent:type_name -> CancelInviteContent
+	145, // 165: WebcastLinkLayerMessage.leaveContent:type_name -> LeaveContent
+	146, // 166: WebcastLinkLayerMessage.finishContent:type_name -> FinishChannelContent
+	147, // 167: WebcastLinkLayerMessage.joinDirectContent:type_name -> JoinDirectContent
+	148, // 168: WebcastLinkLayerMessage.joinGroupContent:type_name -> JoinGroupContent
+	149, // 169: WebcastLinkLayerMessage.permitGroupContent:type_name -> PermitJoinGroupContent
+	150, // 170: WebcastLinkLayerMessage.cancelGroupContent:type_name -> CancelJoinGroupContent
+	151, // 171: WebcastLinkLayerMessage.leaveGroupContent:type_name -> LeaveJoinGroupContent
+	152, // 172: WebcastLinkLayerMessage.p2pGroupChangeContent:type_name -> P2PGroupChangeContent
+	153, // 173: WebcastLinkLayerMessage.businessContent:type_name -> BusinessContent
+	86,  // 174: RoomVerifyMessage.common:type_name -> Common
+	90,  // 175: WebcastGiftMessage.PublicAreaCommon.userLabel:type_name -> Image
+	87,  // 176: WebcastBarrageMessage.BarrageTypeUserGradeParam.user:type_name -> User
+	87,  // 177: WebcastBarrageMessage.BarrageTypeFansLevelParam.user:type_name -> User
+	94,  // 178: WebcastChatMessage.EmoteWithIndex.emote:type_name -> Emote
+	91,  // 179: WebcastControlMessage.Extra.title:type_name -> Text
+	91,  // 180: WebcastControlMessage.Extra.violationReason:type_name -> Text
+	91,  // 181: WebcastControlMessage.Extra.content:type_name -> Text
+	91,  // 182: WebcastControlMessage.Extra.gotItButton:type_name -> Text
+	91,  // 183: WebcastControlMessage.Extra.banDetailButton:type_name -> Text
+	154, // 184: WebcastEnvelopeMessage.EnvelopeInfo.businessType:type_name -> EnvelopeBusinessType
+	90,  // 185: WebcastEnvelopeMessage.EnvelopeInfo.sendUserAvatar:type_name -> Image
+	155, // 186: WebcastEnvelopeMessage.EnvelopeInfo.followShowStatus:type_name -> EnvelopeFollowShowStatus
+	87,  // 187: WebcastRoomUserSeqMessage.Contributor.user:type_name -> User
+	91,  // 188: WebcastRankUpdateMessage.RankTabInfo.titleText:type_name -> Text
+	91,  // 189: WebcastRankUpdateMessage.RankUpdate.defaultContent:type_name -> Text
+	90,  // 190: WebcastMemberMessage.EffectConfig.icon:type_name -> Image
+	91,  // 191: WebcastMemberMessage.EffectConfig.text:type_name -> Text
+	90,  // 192: WebcastMemberMessage.EffectConfig.textIcon:type_name -> Image
+	90,  // 193: WebcastMemberMessage.EffectConfig.badge:type_name -> Image
+	87,  // 194: WebcastQuestionNewMessage.QuestionDetails.user:type_name -> User
+	63,  // 195: WebcastHourlyRankMessage.RankContainer.rankingdata:type_name -> WebcastHourlyRankMessage.RankContainer.RankingData
+	156, // 196: WebcastHourlyRankMessage.RankContainer.rankings:type_name -> Ranking
+	64,  // 197: WebcastHourlyRankMessage.RankContainer.rankingdata2:type_name -> WebcastHourlyRankMessage.RankContainer.RankingData2
+	156, // 198: WebcastHourlyRankMessage.RankContainer.RankingData.rankdata:type_name -> Ranking
+	156, // 199: WebcastHourlyRankMessage.RankContainer.RankingData2.rankdata:type_name -> Ranking
+	67,  // 200: WebcastLinkmicBattleTaskMessage.LinkmicBattleTaskData.Data1:type_name -> WebcastLinkmicBattleTaskMessage.BattleTaskData
+	76,  // 201: WebcastLinkMicBattle.Host2v2Data.hostdata:type_name -> WebcastLinkMicBattle.Host2v2Data.HostData
+	72,  // 202: WebcastLinkMicBattle.LinkMicBattleTeamData.data:type_name -> WebcastLinkMicBattle.LinkMicBattleData
+	77,  // 203: WebcastLinkMicBattle.LinkMicBattleDetails.summary:type_name -> WebcastLinkMicBattle.LinkMicBattleDetails.LinkMicBattleDetailsSummary
+	78,  // 204: WebcastLinkMicBattle.LinkMicBattleTopViewers.viewerGroup:type_name -> WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup
+	80,  // 205: WebcastLinkMicBattle.LinkMicBattleHost.hostGroup:type_name -> WebcastLinkMicBattle.LinkMicBattleHost.HostGroup
+	79,  // 206: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.viewer:type_name -> WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.TopViewer
+	90,  // 207: WebcastLinkMicBattle.LinkMicBattleTopViewers.TopViewerGroup.TopViewer.images:type_name -> Image
+	81,  // 208: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.host:type_name -> WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.Host
+	90,  // 209: WebcastLinkMicBattle.LinkMicBattleHost.HostGroup.Host.images:type_name -> Image
+	157, // 210: WebcastOecLiveShoppingMessage.LiveShoppingDetails.data:type_name -> ValueLabel
+	211, // [211:211] is the sub-list for method output_type
+	211, // [211:211] is the sub-list for method input_type
+	211, // [211:211] is the sub-list for extension type_name
+	211, // [211:211] is the sub-list for extension extendee
+	0,   // [0:211] is the sub-list for field type_name
 }
 
 func init() { file_webcast_proto_init() }
@@ -9136,7 +9189,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastGiftMessage); i {
+			switch v := v.(*WebcastMessageCommon); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9148,7 +9201,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*RoomMessage); i {
+			switch v := v.(*WebcastGiftMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9160,7 +9213,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastRoomMessage); i {
+			switch v := v.(*RoomMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9172,7 +9225,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastBarrageMessage); i {
+			switch v := v.(*WebcastRoomMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9184,7 +9237,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastCaptionMessage); i {
+			switch v := v.(*WebcastBarrageMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9196,7 +9249,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastChatMessage); i {
+			switch v := v.(*WebcastCaptionMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9208,7 +9261,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastControlMessage); i {
+			switch v := v.(*WebcastChatMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9220,7 +9273,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastEmoteChatMessage); i {
+			switch v := v.(*WebcastControlMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9232,7 +9285,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastEnvelopeMessage); i {
+			switch v := v.(*WebcastEmoteChatMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9244,7 +9297,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastGoalUpdateMessage); i {
+			switch v := v.(*WebcastEnvelopeMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9256,7 +9309,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastImDeleteMessage); i {
+			switch v := v.(*WebcastGoalUpdateMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9268,7 +9321,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastInRoomBannerMessage); i {
+			switch v := v.(*WebcastImDeleteMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9280,7 +9333,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLikeMessage); i {
+			switch v := v.(*WebcastInRoomBannerMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9292,7 +9345,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastRoomUserSeqMessage); i {
+			switch v := v.(*WebcastLikeMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9304,7 +9357,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastSocialMessage); i {
+			switch v := v.(*WebcastRoomUserSeqMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9316,7 +9369,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastSubNotifyMessage); i {
+			switch v := v.(*WebcastSocialMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9328,7 +9381,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastRankUpdateMessage); i {
+			switch v := v.(*WebcastSubNotifyMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9340,7 +9393,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastMemberMessage); i {
+			switch v := v.(*WebcastRankUpdateMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9352,7 +9405,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastPollMessage); i {
+			switch v := v.(*WebcastMemberMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9364,7 +9417,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastQuestionNewMessage); i {
+			switch v := v.(*WebcastPollMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9376,7 +9429,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastRankTextMessage); i {
+			switch v := v.(*WebcastQuestionNewMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9388,7 +9441,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastHourlyRankMessage); i {
+			switch v := v.(*WebcastRankTextMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9400,7 +9453,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkMicArmies); i {
+			switch v := v.(*WebcastHourlyRankMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9412,7 +9465,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkMicBattlePunishFinish); i {
+			switch v := v.(*WebcastLinkMicArmies); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9424,7 +9477,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkmicBattleTaskMessage); i {
+			switch v := v.(*WebcastLinkMicBattlePunishFinish); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9436,7 +9489,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkMicBattle); i {
+			switch v := v.(*WebcastLinkmicBattleTaskMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9448,7 +9501,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkMicFanTicketMethod); i {
+			switch v := v.(*WebcastLinkMicBattle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9460,7 +9513,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkMicMethod); i {
+			switch v := v.(*WebcastLinkMicFanTicketMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9472,7 +9525,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLiveIntroMessage); i {
+			switch v := v.(*WebcastLinkMicMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9484,7 +9537,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastUnauthorizedMemberMessage); i {
+			switch v := v.(*WebcastLiveIntroMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9496,7 +9549,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastMsgDetectMessage); i {
+			switch v := v.(*WebcastUnauthorizedMemberMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9508,7 +9561,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastOecLiveShoppingMessage); i {
+			switch v := v.(*WebcastMsgDetectMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9520,7 +9573,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastRoomPinMessage); i {
+			switch v := v.(*WebcastOecLiveShoppingMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9532,7 +9585,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLiveGameIntroMessage); i {
+			switch v := v.(*WebcastRoomPinMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9544,7 +9597,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastSystemMessage); i {
+			switch v := v.(*WebcastLiveGameIntroMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9556,7 +9609,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkMessage); i {
+			switch v := v.(*WebcastSystemMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9568,7 +9621,7 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*WebcastLinkLayerMessage); i {
+			switch v := v.(*WebcastLinkMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9580,6 +9633,18 @@ func file_webcast_proto_init() {
 			}
 		}
 		file_webcast_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*WebcastLinkLayerMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webcast_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*RoomVerifyMessage); i {
 			case 0:
 				return &v.state
@@ -9591,7 +9656,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[42].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastResponse_Message); i {
 			case 0:
 				return &v.state
@@ -9603,7 +9668,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[43].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastGiftMessage_UserGiftReciever); i {
 			case 0:
 				return &v.state
@@ -9615,7 +9680,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[44].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastGiftMessage_GiftIMPriority); i {
 			case 0:
 				return &v.state
@@ -9627,7 +9692,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[45].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastGiftMessage_PublicAreaCommon); i {
 			case 0:
 				return &v.state
@@ -9639,7 +9704,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[46].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[47].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastBarrageMessage_BarrageTypeUserGradeParam); i {
 			case 0:
 				return &v.state
@@ -9651,7 +9716,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[47].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[48].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastBarrageMessage_BarrageTypeFansLevelParam); i {
 			case 0:
 				return &v.state
@@ -9663,7 +9728,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[48].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastBarrageMessage_BarrageTypeSubscribeGiftParam); i {
 			case 0:
 				return &v.state
@@ -9675,7 +9740,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[49].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastBarrageMessage_BarrageEvent); i {
 			case 0:
 				return &v.state
@@ -9687,7 +9752,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[50].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastCaptionMessage_CaptionData); i {
 			case 0:
 				return &v.state
@@ -9699,7 +9764,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[52].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastChatMessage_EmoteWithIndex); i {
 			case 0:
 				return &v.state
@@ -9711,7 +9776,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[53].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastControlMessage_Extra); i {
 			case 0:
 				return &v.state
@@ -9723,7 +9788,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[54].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastEnvelopeMessage_EnvelopeInfo); i {
 			case 0:
 				return &v.state
@@ -9735,7 +9800,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[55].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastRoomUserSeqMessage_Contributor); i {
 			case 0:
 				return &v.state
@@ -9747,7 +9812,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[56].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastRankUpdateMessage_RankTabInfo); i {
 			case 0:
 				return &v.state
@@ -9759,7 +9824,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[57].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastRankUpdateMessage_RankUpdate); i {
 			case 0:
 				return &v.state
@@ -9771,7 +9836,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[58].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastMemberMessage_EffectConfig); i {
 			case 0:
 				return &v.state
@@ -9783,7 +9848,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[59].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastQuestionNewMessage_QuestionDetails); i {
 			case 0:
 				return &v.state
@@ -9795,7 +9860,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[60].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastHourlyRankMessage_RankContainer); i {
 			case 0:
 				return &v.state
@@ -9807,7 +9872,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[61].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastHourlyRankMessage_RankContainer_RankingData); i {
 			case 0:
 				return &v.state
@@ -9819,7 +9884,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[62].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastHourlyRankMessage_RankContainer_RankingData2); i {
 			case 0:
 				return &v.state
@@ -9831,7 +9896,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[63].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattlePunishFinish_LinkMicBattlePunishFinishData); i {
 			case 0:
 				return &v.state
@@ -9843,7 +9908,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[64].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[65].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData); i {
 			case 0:
 				return &v.state
@@ -9855,7 +9920,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[65].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[66].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkmicBattleTaskMessage_BattleTaskData); i {
 			case 0:
 				return &v.state
@@ -9867,7 +9932,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[66].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[67].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkmicBattleTaskMessage_LinkmicBattleTaskData2); i {
 			case 0:
 				return &v.state
@@ -9879,7 +9944,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[67].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_Host2V2Data); i {
 			case 0:
 				return &v.state
@@ -9891,7 +9956,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[68].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[69].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleConfig); i {
 			case 0:
 				return &v.state
@@ -9903,7 +9968,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[69].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[70].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleTeamData); i {
 			case 0:
 				return &v.state
@@ -9915,7 +9980,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[70].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[71].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleData); i {
 			case 0:
 				return &v.state
@@ -9927,7 +9992,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[71].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[72].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleDetails); i {
 			case 0:
 				return &v.state
@@ -9939,7 +10004,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[72].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[73].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleTopViewers); i {
 			case 0:
 				return &v.state
@@ -9951,7 +10016,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[73].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[74].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleHost); i {
 			case 0:
 				return &v.state
@@ -9963,7 +10028,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[74].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[75].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_Host2V2Data_HostData); i {
 			case 0:
 				return &v.state
@@ -9975,7 +10040,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[75].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[76].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleDetails_LinkMicBattleDetailsSummary); i {
 			case 0:
 				return &v.state
@@ -9987,7 +10052,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[76].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[77].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup); i {
 			case 0:
 				return &v.state
@@ -9999,7 +10064,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[77].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[78].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleTopViewers_TopViewerGroup_TopViewer); i {
 			case 0:
 				return &v.state
@@ -10011,7 +10076,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[78].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup); i {
 			case 0:
 				return &v.state
@@ -10023,7 +10088,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[79].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[80].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastLinkMicBattle_LinkMicBattleHost_HostGroup_Host); i {
 			case 0:
 				return &v.state
@@ -10035,7 +10100,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[80].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[81].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastMsgDetectMessage_TimeInfo); i {
 			case 0:
 				return &v.state
@@ -10047,7 +10112,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[81].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[82].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastMsgDetectMessage_TriggerCondition); i {
 			case 0:
 				return &v.state
@@ -10059,7 +10124,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[82].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[83].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastOecLiveShoppingMessage_LiveShoppingData); i {
 			case 0:
 				return &v.state
@@ -10071,7 +10136,7 @@ func file_webcast_proto_init() {
 				return nil
 			}
 		}
-		file_webcast_proto_msgTypes[83].Exporter = func(v any, i int) any {
+		file_webcast_proto_msgTypes[84].Exporter = func(v any, i int) any {
 			switch v := v.(*WebcastOecLiveShoppingMessage_LiveShoppingDetails); i {
 			case 0:
 				return &v.state
@@ -10090,7 +10155,7 @@ func file_webcast_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_webcast_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   84,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
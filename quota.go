@@ -0,0 +1,173 @@
+package gotiktoklive
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultQuotaRefreshInterval = 5 * time.Minute
+	// quotaRefreshTimeout bounds a single fetch of the signer limits.
+	quotaRefreshTimeout = 10 * time.Second
+	// Used when the signer limits are not validated, see DisableSigningLimitsValidation, or cannot be fetched
+	defaultSignsPerMinute = 10
+)
+
+// ErrSignerQuotaExhausted is returned instead of signing when the signer's budget for a window is used up. The
+// request can be retried at ResetAt.
+type ErrSignerQuotaExhausted struct {
+	Signer  string
+	Window  string
+	ResetAt time.Time
+}
+
+func (e ErrSignerQuotaExhausted) Error() string {
+	return fmt.Sprintf("signer %s quota for the %s is exhausted, resets at %s", e.Signer, e.Window, e.ResetAt.Format(time.RFC3339))
+}
+
+// SignerQuota is a snapshot of the known signing budget of a signer.
+type SignerQuota struct {
+	Signer      string
+	Day         LimitInfo
+	Hour        LimitInfo
+	Minute      LimitInfo
+	RefreshedAt time.Time
+}
+
+// quotaReporter is implemented by signers that track a signing quota.
+type quotaReporter interface {
+	quotas() []SignerQuota
+}
+
+// quotaManager tracks the day, hour and minute signing budgets of a signer locally and refreshes them from the
+// signer's rate_limits endpoint every refreshEvery. A window with a Max of 0 is not limited.
+type quotaManager struct {
+	signer       string
+	fetch        func(ctx context.Context) (SigningLimits, error)
+	refreshEvery time.Duration
+
+	mu          sync.Mutex
+	limits      SigningLimits
+	refreshedAt time.Time
+	stale       bool
+	refreshing  bool
+}
+
+// newQuotaManager creates a quota from the limits fetched with ctx, fetch is nil when the limits are not validated.
+// When the limits cannot be fetched the quota starts with the default limits and the error is returned along with
+// it, the limits are fetched again after refreshEvery.
+func newQuotaManager(ctx context.Context, signer string, fetch func(ctx context.Context) (SigningLimits, error)) (*quotaManager, error) {
+	q := &quotaManager{
+		signer:       signer,
+		fetch:        fetch,
+		refreshEvery: defaultQuotaRefreshInterval,
+	}
+	q.limits.Minute = LimitInfo{Max: defaultSignsPerMinute, Remaining: defaultSignsPerMinute}
+	if fetch == nil {
+		return q, nil
+	}
+	return q, q.refresh(ctx)
+}
+
+// refresh fetches the limits, when that fails the current limits are kept until the next refresh is due.
+func (q *quotaManager) refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, quotaRefreshTimeout)
+	defer cancel()
+	limits, err := q.fetch(ctx)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.refreshedAt = time.Now()
+	q.stale = false
+	q.refreshing = false
+	if err != nil {
+		return err
+	}
+	q.limits = limits
+	return nil
+}
+
+// take uses one request from every window, or returns ErrSignerQuotaExhausted for the first exhausted window. When
+// the limits are due for a refresh they are fetched first with ctx, other takes meanwhile use the current limits.
+func (q *quotaManager) take(ctx context.Context) error {
+	if q.refreshDue() {
+		// Keep the local accounting when the signer cannot be reached, signing itself will tell if it is down.
+		_ = q.refresh(ctx)
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+	windows := []struct {
+		name   string
+		length time.Duration
+		info   *LimitInfo
+	}{
+		{"day", 24 * time.Hour, &q.limits.Day},
+		{"hour", time.Hour, &q.limits.Hour},
+		{"minute", time.Minute, &q.limits.Minute},
+	}
+	for _, w := range windows {
+		if w.info.Max <= 0 {
+			continue
+		}
+		if w.info.ResetAt.IsZero() {
+			w.info.ResetAt = now.Add(w.length)
+		}
+		if !now.Before(w.info.ResetAt) {
+			w.info.Remaining = w.info.Max
+			for !now.Before(w.info.ResetAt) {
+				w.info.ResetAt = w.info.ResetAt.Add(w.length)
+			}
+		}
+		if w.info.Remaining <= 0 {
+			return &ErrSignerQuotaExhausted{Signer: q.signer, Window: w.name, ResetAt: w.info.ResetAt}
+		}
+	}
+	for _, w := range windows {
+		if w.info.Max > 0 {
+			w.info.Remaining--
+		}
+	}
+	return nil
+}
+
+// refreshDue tells if the limits should be refreshed and if so marks the refresh as running, so only one take
+// fetches them.
+func (q *quotaManager) refreshDue() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.fetch == nil || q.refreshing || !q.stale && time.Since(q.refreshedAt) <= q.refreshEvery {
+		return false
+	}
+	q.refreshing = true
+	return true
+}
+
+// markStale makes the next take refresh the limits, e.g. after the signer said the rate limit was exceeded.
+func (q *quotaManager) markStale() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.stale = true
+}
+
+func (q *quotaManager) snapshot() SignerQuota {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return SignerQuota{
+		Signer:      q.signer,
+		Day:         q.limits.Day,
+		Hour:        q.limits.Hour,
+		Minute:      q.limits.Minute,
+		RefreshedAt: q.refreshedAt,
+	}
+}
+
+// SignerQuota returns the known signing budget of every signer in use, for example to show on a dashboard. Signers
+// that do not track a quota, such as custom signers, are not included.
+func (t *TikTok) SignerQuota() []SignerQuota {
+	if r, ok := t.signer.(quotaReporter); ok {
+		return r.quotas()
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package gotiktoklive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func TestSignerQuotaExhausted(t *testing.T) {
+	srv := webcasttest.NewServer(webcasttest.WithSignerLimits(100, 10, 1))
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv)
+	quotas := tiktok.SignerQuota()
+	if !assert.Len(t, quotas, 1) {
+		return
+	}
+	assert.Equal(t, srv.SignerURL(), quotas[0].Signer)
+	assert.Equal(t, 1, quotas[0].Minute.Remaining)
+
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	live.Close()
+
+	quota := tiktok.SignerQuota()[0]
+	assert.Equal(t, 99, quota.Day.Remaining)
+	assert.Equal(t, 9, quota.Hour.Remaining)
+	assert.Equal(t, 0, quota.Minute.Remaining)
+
+	_, err = tiktok.TrackRoom(srv.RoomID())
+	var exhausted *ErrSignerQuotaExhausted
+	if assert.True(t, errors.As(err, &exhausted), "got %v", err) {
+		assert.Equal(t, "minute", exhausted.Window)
+		assert.Equal(t, quota.Minute.ResetAt, exhausted.ResetAt)
+	}
+	assert.Len(t, srv.FetchCursors(), 1, "exhausted quota must not reach the signer")
+}
+
+func TestQuotaManagerReset(t *testing.T) {
+	now := time.Now()
+	q := &quotaManager{signer: "test"}
+	q.limits.Minute = LimitInfo{Max: 2, Remaining: 0, ResetAt: now.Add(-90 * time.Second)}
+	q.limits.Hour = LimitInfo{Max: 5, Remaining: 5}
+
+	assert.NoError(t, q.take(context.Background()))
+	snapshot := q.snapshot()
+	assert.Equal(t, 1, snapshot.Minute.Remaining)
+	assert.True(t, snapshot.Minute.ResetAt.After(now), "reset should move to the next window")
+	assert.Equal(t, 4, snapshot.Hour.Remaining)
+	assert.Equal(t, 0, snapshot.Day.Remaining, "unlimited windows are not counted")
+}
+
+func TestQuotaManagerRefreshFailure(t *testing.T) {
+	fetched := make(chan struct{}, 2)
+	release := make(chan struct{})
+	fail := true
+	q, err := newQuotaManager(context.Background(), "test", func(ctx context.Context) (SigningLimits, error) {
+		if fail {
+			return SigningLimits{}, errors.New("signer down")
+		}
+		fetched <- struct{}{}
+		<-release
+		return SigningLimits{Minute: LimitInfo{Max: 50, Remaining: 50}}, nil
+	})
+	assert.Error(t, err)
+	if !assert.NotNil(t, q) {
+		return
+	}
+	assert.Equal(t, defaultSignsPerMinute, q.snapshot().Minute.Max, "falls back to the default limits")
+	assert.False(t, q.snapshot().RefreshedAt.IsZero(), "a failed refresh waits for the next interval")
+
+	// A due refresh is done by one take, the others do not wait for it.
+	fail = false
+	q.mu.Lock()
+	q.refreshedAt = time.Now().Add(-2 * q.refreshEvery)
+	q.mu.Unlock()
+	refreshed := make(chan error)
+	go func() {
+		refreshed <- q.take(context.Background())
+	}()
+	<-fetched
+	assert.NoError(t, q.take(context.Background()))
+	assert.Len(t, fetched, 0, "only one refresh at a time")
+	close(release)
+	assert.NoError(t, <-refreshed)
+	assert.Equal(t, 50, q.snapshot().Minute.Max)
+}
+
+func TestQuotaManagerRefreshCancel(t *testing.T) {
+	// Fetching the limits stops with the context of the caller.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	q, err := newQuotaManager(ctx, "test", func(ctx context.Context) (SigningLimits, error) {
+		<-ctx.Done()
+		return SigningLimits{}, ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	q.markStale()
+	assert.NoError(t, q.take(ctx))
+	assert.Equal(t, defaultSignsPerMinute-1, q.snapshot().Minute.Remaining)
+}
@@ -0,0 +1,196 @@
+package gotiktoklive
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// RankUpdateEvent is sent when the position of the host changes in one or more rankings, such as the hourly or weekly
+// leaderboards. Live.Rankings returns the latest standing in every ranking.
+type RankUpdateEvent struct {
+	MessageID int64
+	Timestamp int64
+	Updates   []RankUpdate
+	isHistory bool
+}
+
+func (r RankUpdateEvent) IsHistory() bool {
+	return r.isHistory
+}
+
+func (r RankUpdateEvent) CreatedTimestamp() int64 {
+	return r.Timestamp
+}
+
+// RankUpdate is the position of the host in a ranking. RankType identifies the ranking tab, Title is its name when
+// TikTok sent it.
+type RankUpdate struct {
+	RankType int64
+	Title    string
+	Rank     int64
+	// PreviousRank is the position before this update, as sent by TikTok or else the last known one, 0 when unknown.
+	PreviousRank int64
+	OnRank       bool
+	// Countdown is the time left until the ranking resets, zero when TikTok does not show one.
+	Countdown time.Duration
+	Text      DisplayText
+}
+
+// HourlyRankEvent carries the hourly ranking banners shown in the room.
+type HourlyRankEvent struct {
+	MessageID int64
+	Timestamp int64
+	Rankings  []HourlyRanking
+	isHistory bool
+}
+
+func (h HourlyRankEvent) IsHistory() bool {
+	return h.isHistory
+}
+
+func (h HourlyRankEvent) CreatedTimestamp() int64 {
+	return h.Timestamp
+}
+
+// HourlyRanking is a banner of a HourlyRankEvent, such as "Hourly ranking" with the position of the host in Details.
+type HourlyRanking struct {
+	Type    string
+	Label   string
+	Color   string
+	Details []RankLabel
+}
+
+// RankLabel is a value of a HourlyRanking with its label.
+type RankLabel struct {
+	Value int
+	Label string
+}
+
+// RankStanding is the latest known position of the host in a ranking.
+type RankStanding struct {
+	RankUpdate
+	UpdatedAt time.Time
+	// ResetsAt is when the ranking resets on the local clock, zero without a countdown.
+	ResetsAt time.Time
+}
+
+func toRankUpdateEvent(pt *pb.WebcastRankUpdateMessage, isHistory bool) RankUpdateEvent {
+	titles := make(map[int64]string, len(pt.TabsList))
+	for _, tab := range pt.TabsList {
+		titles[tab.RankType] = tab.Title
+		if tab.Title == "" {
+			titles[tab.RankType] = toDisplayText(tab.TitleText).Text
+		}
+	}
+	e := RankUpdateEvent{
+		MessageID: pt.Common.MsgId,
+		Timestamp: pt.Common.CreateTime,
+		isHistory: isHistory,
+	}
+	for _, u := range pt.UpdatesList {
+		e.Updates = append(e.Updates, RankUpdate{
+			RankType:  u.RankType,
+			Title:     titles[u.RankType],
+			Rank:      u.OwnerRank,
+			OnRank:    u.Owneronrank,
+			Countdown: time.Duration(u.Countdown) * time.Second,
+			Text:      toDisplayText(u.DefaultContent),
+		})
+	}
+	return e
+}
+
+// toRankTextEvent converts the message TikTok sends when the host moves in a ranking. Scene is used as the rank type.
+func toRankTextEvent(pt *pb.WebcastRankTextMessage, isHistory bool) RankUpdateEvent {
+	return RankUpdateEvent{
+		MessageID: pt.Common.MsgId,
+		Timestamp: pt.Common.CreateTime,
+		Updates: []RankUpdate{{
+			RankType:     int64(pt.Scene),
+			Rank:         pt.OwnerIdxAfterUpdate,
+			PreviousRank: pt.OwnerIdxBeforeUpdate,
+			OnRank:       pt.OwnerIdxAfterUpdate > 0,
+			Text:         toDisplayText(pt.OtherGetBadgeMsg),
+		}},
+		isHistory: isHistory,
+	}
+}
+
+func toHourlyRankEvent(pt *pb.WebcastHourlyRankMessage, isHistory bool) HourlyRankEvent {
+	e := HourlyRankEvent{
+		MessageID: pt.Common.MsgId,
+		Timestamp: pt.Common.CreateTime,
+		isHistory: isHistory,
+	}
+	data := pt.GetData()
+	for _, r := range []*pb.Ranking{data.GetRankingdata().GetRankdata(), data.GetRankings(), data.GetRankingdata2().GetRankdata()} {
+		if r == nil {
+			continue
+		}
+		ranking := HourlyRanking{Type: r.Type, Label: r.Label, Color: r.GetColor().GetColor()}
+		for _, d := range r.Details {
+			label := d.Label
+			if label == "" {
+				label = d.Label2
+			}
+			ranking.Details = append(ranking.Details, RankLabel{Value: int(d.Data), Label: label})
+		}
+		e.Rankings = append(e.Rankings, ranking)
+	}
+	return e
+}
+
+// rankTracker keeps the latest standing of the host by rank type.
+type rankTracker struct {
+	mu        sync.Mutex
+	standings map[int64]RankStanding
+}
+
+// trackRank fills in the titles and previous positions the update lacks from the known standings and stores the new
+// ones.
+func (l *Live) trackRank(e RankUpdateEvent) RankUpdateEvent {
+	tr := &l.ranks
+	now := time.Now()
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.standings == nil {
+		tr.standings = make(map[int64]RankStanding)
+	}
+	updates := make([]RankUpdate, len(e.Updates))
+	for i, u := range e.Updates {
+		if known, ok := tr.standings[u.RankType]; ok {
+			if u.Title == "" {
+				u.Title = known.Title
+			}
+			if u.PreviousRank == 0 {
+				u.PreviousRank = known.Rank
+			}
+		}
+		standing := RankStanding{RankUpdate: u, UpdatedAt: now}
+		if u.Countdown > 0 {
+			standing.ResetsAt = now.Add(u.Countdown)
+		}
+		tr.standings[u.RankType] = standing
+		updates[i] = u
+	}
+	e.Updates = updates
+	return e
+}
+
+// Rankings returns the latest known standing of the host in every ranking, ordered by rank type.
+func (l *Live) Rankings() []RankStanding {
+	tr := &l.ranks
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	standings := make([]RankStanding, 0, len(tr.standings))
+	for _, s := range tr.standings {
+		standings = append(standings, s)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		return standings[i].RankType < standings[j].RankType
+	})
+	return standings
+}
@@ -0,0 +1,70 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func rankUpdateMessage(msgID, rank int64, tabs ...*pb.WebcastRankUpdateMessage_RankTabInfo) *pb.WebcastRankUpdateMessage {
+	return &pb.WebcastRankUpdateMessage{
+		Common: &pb.Common{Method: "WebcastRankUpdateMessage", MsgId: msgID, CreateTime: 1000},
+		UpdatesList: []*pb.WebcastRankUpdateMessage_RankUpdate{{
+			RankType:       8,
+			OwnerRank:      rank,
+			Owneronrank:    true,
+			Countdown:      600,
+			DefaultContent: &pb.Text{DefaultPattern: "No. {0:string} in hourly ranking", PiecesList: []*pb.Text_TextPiece{{StringValue: "3"}}},
+		}},
+		TabsList: tabs,
+	}
+}
+
+func TestRankings(t *testing.T) {
+	live := &Live{}
+	first, ok := parseTestMsg(t, rankUpdateMessage(1, 5, &pb.WebcastRankUpdateMessage_RankTabInfo{RankType: 8, Title: "Hourly"})).(RankUpdateEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	first = live.track(first)[0].(RankUpdateEvent)
+	if assert.Len(t, first.Updates, 1) {
+		assert.Equal(t, "Hourly", first.Updates[0].Title)
+		assert.Equal(t, int64(5), first.Updates[0].Rank)
+		assert.Zero(t, first.Updates[0].PreviousRank)
+		assert.Equal(t, 10*time.Minute, first.Updates[0].Countdown)
+		assert.Equal(t, "No. 3 in hourly ranking", first.Updates[0].Text.Text)
+	}
+
+	second := live.track(parseTestMsg(t, rankUpdateMessage(2, 3)))[0].(RankUpdateEvent)
+	assert.Equal(t, "Hourly", second.Updates[0].Title, "title kept from the tabs of an earlier update")
+	assert.Equal(t, int64(5), second.Updates[0].PreviousRank)
+
+	rankings := live.Rankings()
+	if assert.Len(t, rankings, 1) {
+		assert.Equal(t, int64(3), rankings[0].Rank)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), rankings[0].ResetsAt, time.Minute)
+	}
+}
+
+func TestHourlyRankEvent(t *testing.T) {
+	msg := &pb.WebcastHourlyRankMessage{
+		Common: &pb.Common{Method: "WebcastHourlyRankMessage", MsgId: 1, CreateTime: 1000},
+		Data: &pb.WebcastHourlyRankMessage_RankContainer{
+			Rankings: &pb.Ranking{
+				Type:    "hourly",
+				Label:   "Hourly ranking",
+				Color:   &pb.TikTokColor{Color: "#ffcc00"},
+				Details: []*pb.ValueLabel{{Data: 4, Label: "position"}},
+			},
+		},
+	}
+	e, ok := parseTestMsg(t, msg).(HourlyRankEvent)
+	if assert.True(t, ok) && assert.Len(t, e.Rankings, 1) {
+		assert.Equal(t, "Hourly ranking", e.Rankings[0].Label)
+		assert.Equal(t, "#ffcc00", e.Rankings[0].Color)
+		assert.Equal(t, []RankLabel{{Value: 4, Label: "position"}}, e.Rankings[0].Details)
+	}
+}
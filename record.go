@@ -0,0 +1,190 @@
+package gotiktoklive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Recordings store a raw webcast session so it can be replayed with ReplayLive. A recording starts with recordMagic
+// followed by records, each record is:
+//
+//	kind      1 byte, see RecordKind
+//	time      8 bytes, big endian unix nanoseconds the data was received
+//	length    uvarint
+//	data      length bytes
+const recordMagic = "GTTLREC\x01"
+
+// maxRecordSize guards against reading garbage as a huge length.
+const maxRecordSize = 64 << 20
+
+var ErrNotARecording = errors.New("not a gotiktoklive recording")
+
+// RecordKind tells what the data of a Record holds.
+type RecordKind byte
+
+const (
+	// RecordRoomID data is the room id as a string, always the first record.
+	RecordRoomID RecordKind = iota + 1
+	// RecordRoomInfo data is the room/info/ json response.
+	RecordRoomInfo
+	// RecordRoomData data is the protobuf WebcastResponse of the room data fetch, sent again after reconnects.
+	RecordRoomData
+	// RecordPushFrame data is a protobuf WebcastPushFrame as received from the websocket.
+	RecordPushFrame
+)
+
+func (k RecordKind) String() string {
+	switch k {
+	case RecordRoomID:
+		return "room id"
+	case RecordRoomInfo:
+		return "room info"
+	case RecordRoomData:
+		return "room data"
+	case RecordPushFrame:
+		return "push frame"
+	}
+	return fmt.Sprintf("unknown record kind %d", byte(k))
+}
+
+// Record is a single entry of a recording.
+type Record struct {
+	Kind RecordKind
+	Time time.Time
+	Data []byte
+}
+
+// RecordWriter writes a recording, it is safe for concurrent use.
+type RecordWriter struct {
+	mu sync.Mutex
+	w  *bufio.Writer
+	c  io.Closer
+}
+
+// NewRecordWriter starts a recording on w. If w is an io.Closer it is closed by Close.
+func NewRecordWriter(w io.Writer) (*RecordWriter, error) {
+	rw := &RecordWriter{w: bufio.NewWriter(w)}
+	if c, ok := w.(io.Closer); ok {
+		rw.c = c
+	}
+	if _, err := rw.w.WriteString(recordMagic); err != nil {
+		return nil, err
+	}
+	return rw, rw.w.Flush()
+}
+
+// Write appends rec to the recording and flushes it so a crash loses as little as possible.
+func (w *RecordWriter) Write(rec Record) error {
+	var hdr [1 + 8 + binary.MaxVarintLen64]byte
+	hdr[0] = byte(rec.Kind)
+	binary.BigEndian.PutUint64(hdr[1:9], uint64(rec.Time.UnixNano()))
+	n := binary.PutUvarint(hdr[9:], uint64(len(rec.Data)))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.w.Write(hdr[:9+n]); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(rec.Data); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// Close flushes the recording and closes the underlying writer when it is closable.
+func (w *RecordWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	err := w.w.Flush()
+	if w.c != nil {
+		err = errors.Join(err, w.c.Close())
+	}
+	return err
+}
+
+// RecordReader reads a recording written by RecordWriter.
+type RecordReader struct {
+	r *bufio.Reader
+}
+
+// NewRecordReader checks r is a recording and returns a reader for its records.
+func NewRecordReader(r io.Reader) (*RecordReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(recordMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotARecording, err)
+	}
+	if !bytes.Equal(magic, []byte(recordMagic)) {
+		return nil, ErrNotARecording
+	}
+	return &RecordReader{r: br}, nil
+}
+
+// Next returns the next record, io.EOF is returned at the end of the recording.
+func (r *RecordReader) Next() (Record, error) {
+	var hdr [9]byte
+	if _, err := io.ReadFull(r.r, hdr[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return Record{}, fmt.Errorf("truncated record: %w", err)
+		}
+		return Record{}, err
+	}
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return Record{}, fmt.Errorf("truncated record: %w", err)
+	}
+	if size > maxRecordSize {
+		return Record{}, fmt.Errorf("record of %d bytes is too large", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return Record{}, fmt.Errorf("truncated record: %w", err)
+	}
+	return Record{
+		Kind: RecordKind(hdr[0]),
+		Time: time.Unix(0, int64(binary.BigEndian.Uint64(hdr[1:9]))),
+		Data: data,
+	}, nil
+}
+
+// startRecording creates the recording file for the live when recording is enabled.
+func (l *Live) startRecording() error {
+	if l.t.recordDir == "" {
+		return nil
+	}
+	name := fmt.Sprintf("%s-%s.ttrec", l.ID, time.Now().UTC().Format("20060102T150405.000"))
+	f, err := os.Create(filepath.Join(l.t.recordDir, name))
+	if err != nil {
+		return err
+	}
+	w, err := NewRecordWriter(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	l.recorder.Store(w)
+	l.record(RecordRoomID, []byte(l.ID))
+	return nil
+}
+
+func (l *Live) record(kind RecordKind, data []byte) {
+	w := l.recorder.Load()
+	if w == nil {
+		return
+	}
+	if err := w.Write(Record{Kind: kind, Time: time.Now(), Data: data}); err != nil {
+		// Only the first failing writer stops the recording, both the run and read goroutines record.
+		if l.recorder.CompareAndSwap(w, nil) {
+			l.t.warnHandler(fmt.Errorf("failed to record %s, recording stopped: %w", kind, err))
+			_ = w.Close()
+		}
+	}
+}
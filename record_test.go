@@ -0,0 +1,97 @@
+package gotiktoklive
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func TestRecordReader(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRecordWriter(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	now := time.Unix(0, time.Now().UnixNano())
+	records := []Record{
+		{Kind: RecordRoomID, Time: now, Data: []byte("1234")},
+		{Kind: RecordPushFrame, Time: now.Add(time.Second), Data: []byte{}},
+		{Kind: RecordPushFrame, Time: now.Add(2 * time.Second), Data: bytes.Repeat([]byte{0xff}, 300)},
+	}
+	for _, rec := range records {
+		assert.NoError(t, w.Write(rec))
+	}
+	assert.NoError(t, w.Close())
+
+	rd, err := NewRecordReader(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, want := range records {
+		got, err := rd.Next()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, want.Kind, got.Kind)
+		assert.True(t, want.Time.Equal(got.Time))
+		assert.Equal(t, want.Data, got.Data)
+	}
+	_, err = rd.Next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	_, err = NewRecordReader(bytes.NewBufferString("not a recording"))
+	assert.ErrorIs(t, err, ErrNotARecording)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := webcasttest.NewServer(webcasttest.WithRoomMessages(webcasttest.NewMessage(chatMessage(3001, "from room data"))))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	tiktok := newTestTikTok(t, srv, EnableRecording(dir))
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "from room data", nextEvent[ChatEvent](t, live.Events).Comment)
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+	if !assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(chatMessage(3002, "from websocket")))) {
+		return
+	}
+	assert.Equal(t, "from websocket", nextEvent[ChatEvent](t, live.Events).Comment)
+	live.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, srv.RoomID()+"-*.ttrec"))
+	if !assert.NoError(t, err) || !assert.Len(t, files, 1) {
+		return
+	}
+	f, err := os.Open(files[0])
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	replay, err := ReplayLive(f, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer replay.Close()
+	assert.Equal(t, srv.RoomID(), replay.ID)
+	if assert.NotNil(t, replay.Info) {
+		assert.Equal(t, srv.Username(), replay.Info.Owner.Username)
+	}
+	assert.Equal(t, "from room data", nextEvent[ChatEvent](t, replay.Events).Comment)
+	assert.Equal(t, "from websocket", nextEvent[ChatEvent](t, replay.Events).Comment)
+	nextEvent[*DisconnectEvent](t, replay.Events)
+	_, ok := <-replay.Events
+	assert.False(t, ok, "events should be closed after the recording ends")
+}
@@ -0,0 +1,139 @@
+package gotiktoklive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ReplayLive replays a recording made with EnableRecording. The returned Live has its Info set from the recorded room
+// info and its Events are produced by the same parsing as a live connection, so a recording can be used as an offline
+// fixture. Nothing is sent to TikTok or a signer.
+//
+// speed scales the recorded timing, 1 replays in real time and 2 twice as fast. A speed of 0 or less replays as fast
+// as the Events channel is read. Unlike a live connection no events are dropped when the channel is full. Events is
+// closed after the final DisconnectEvent once the recording ends or the Live is closed.
+func ReplayLive(r io.Reader, speed float64) (*Live, error) {
+	rd, err := NewRecordReader(r)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := rd.Next()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read room id: %w", err)
+	}
+	if rec.Kind != RecordRoomID {
+		return nil, fmt.Errorf("recording starts with %s instead of the room id", rec.Kind)
+	}
+
+	t := &TikTok{
+		wg:            &sync.WaitGroup{},
+		ctx:           context.Background(),
+		done:          context.Background().Done,
+		lives:         make(map[*Live]struct{}),
+		dedupTTL:      defaultDedupTTL,
+		dedupCapacity: defaultDedupCapacity,
+		mu:            &sync.Mutex{},
+		infoHandler:   defaultLogHandler,
+		warnHandler:   defaultLogHandler,
+		debugHandler:  routineErrHandler,
+		errHandler:    routineErrHandler,
+	}
+	live := t.newLive(context.Background(), string(rec.Data))
+	live.replay = true
+
+	// Room info is fetched before anything else, so it is the next record when present.
+	rec, err = rd.Next()
+	if err != nil && !errors.Is(err, io.EOF) {
+		live.close()
+		return nil, err
+	}
+	pending := &rec
+	if err == nil && rec.Kind == RecordRoomInfo {
+		pending = nil
+		live.Info, err = live.parseRoomInfo(rec.Data)
+		if err != nil && !errors.Is(err, ErrLiveHasEnded) {
+			live.close()
+			return nil, fmt.Errorf("cannot parse recorded room info: %w", err)
+		}
+	} else if errors.Is(err, io.EOF) {
+		pending = nil
+	}
+
+	live.wg.Add(1)
+	go func() {
+		defer live.wg.Done()
+		defer close(live.Events)
+		live.replayRecords(rd, pending, speed)
+	}()
+	return live, nil
+}
+
+// replayRecords feeds the recorded room data and push frames through the live parsing, waiting between records as
+// they were received. first is a record already read from rd, if any.
+func (l *Live) replayRecords(rd *RecordReader, first *Record, speed float64) {
+	defer func() {
+		l.stopTrackers()
+		select {
+		case l.Events <- &DisconnectEvent{created: time.Now()}:
+		case <-time.After(5 * time.Second):
+		}
+	}()
+	defer l.cancel()
+
+	var last time.Time
+	for {
+		var rec Record
+		if first != nil {
+			rec, first = *first, nil
+		} else {
+			var err error
+			rec, err = rd.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				l.t.errHandler(fmt.Errorf("failed to read recording: %w", err))
+				return
+			}
+		}
+
+		if speed > 0 && !last.IsZero() && rec.Time.After(last) {
+			select {
+			case <-time.After(time.Duration(float64(rec.Time.Sub(last)) / speed)):
+			case <-l.done():
+				return
+			}
+		}
+		last = rec.Time
+
+		select {
+		case <-l.done():
+			return
+		default:
+		}
+
+		switch rec.Kind {
+		case RecordRoomData:
+			if err := l.parseRoomData(rec.Data); err != nil {
+				l.t.errHandler(fmt.Errorf("failed to parse recorded room data: %w", err))
+			}
+		case RecordPushFrame:
+			if err := l.parseWssMsg(rec.Data); err != nil {
+				l.t.errHandler(fmt.Errorf("Failed to parse websocket message: %w", err))
+			}
+		case RecordRoomInfo:
+			info, err := l.parseRoomInfo(rec.Data)
+			if err != nil && !errors.Is(err, ErrLiveHasEnded) {
+				l.t.errHandler(fmt.Errorf("failed to parse recorded room info: %w", err))
+				continue
+			}
+			l.Info = info
+		default:
+			l.t.warnHandler(fmt.Errorf("skipping %s in recording", rec.Kind))
+		}
+	}
+}
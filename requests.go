@@ -3,11 +3,13 @@ package gotiktoklive
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type reqOptions struct {
@@ -39,10 +41,18 @@ type reqOptions struct {
 	// Specifiy base URI
 	URI                string
 	ExtraTikTokCookies string
+
+	// Timeout for the whole request, no timeout when zero
+	Timeout time.Duration
 }
 
-func (t *TikTok) sendRequest(o *reqOptions, customValidate func(response *http.Response) error) ([]byte, http.Header, error) {
+func (t *TikTok) sendRequest(ctx context.Context, o *reqOptions, customValidate func(response *http.Response) error) ([]byte, http.Header, error) {
 	var err error
+	select {
+	case <-t.done():
+		return nil, nil, ErrClosed
+	default:
+	}
 
 	defer func() {
 		if err != nil {
@@ -54,9 +64,9 @@ func (t *TikTok) sendRequest(o *reqOptions, customValidate func(response *http.R
 		method = "POST"
 	}
 
-	uri := tiktokAPIUrl
+	uri := t.apiUrl
 	if o.OmitAPI {
-		uri = tiktokBaseUrl
+		uri = t.baseUrl
 	}
 	if o.URI != "" {
 		uri = o.URI
@@ -86,11 +96,17 @@ func (t *TikTok) sendRequest(o *reqOptions, customValidate func(response *http.R
 	fullUrl := u.String()
 	if !o.OmitAPI && o.URI == "" && o.Endpoint == urlRoomData {
 		t.debugHandler("signing for url ", fullUrl)
-		return t.signURL(fullUrl, o)
+		return t.signURL(ctx, fullUrl, o)
+	}
+
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
 	}
 
 	var req *http.Request
-	req, err = http.NewRequest(method, fullUrl, reqData)
+	req, err = http.NewRequestWithContext(ctx, method, fullUrl, reqData)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,7 +163,7 @@ func (t *TikTok) sendRequest(o *reqOptions, customValidate func(response *http.R
 		if resp.StatusCode == 403 {
 			return nil, nil, &ErrIPBlockedOrBanned{}
 		}
-		err = fmt.Errorf("received status code %d", resp.StatusCode)
+		err = &ErrBadStatusCode{StatusCode: resp.StatusCode}
 		return body, nil, err
 	}
 	if customValidate != nil {
@@ -0,0 +1,116 @@
+package gotiktoklive
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// ShoppingEvent is sent when the host pins a product of the LIVE shop. Live.Products returns every product shown
+// during the live.
+type ShoppingEvent struct {
+	MessageID int64
+	Timestamp int64
+	Product   *Product
+	isHistory bool
+}
+
+func (s ShoppingEvent) IsHistory() bool {
+	return s.isHistory
+}
+
+func (s ShoppingEvent) CreatedTimestamp() int64 {
+	return s.Timestamp
+}
+
+// Product is a product of the LIVE shop.
+type Product struct {
+	ID    string
+	Title string
+	// Price is the formatted price, such as "$55.99".
+	Price    string
+	Image    string
+	ShopName string
+	ShopURL  string
+	// StartsAt and EndsAt are when the product showcase starts and ends, zero when not sent.
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// ShownProduct is a product shown during the live, with when it was first and last pinned.
+type ShownProduct struct {
+	Product
+	FirstShown time.Time
+	LastShown  time.Time
+	Times      int
+}
+
+func toShoppingEvent(pt *pb.WebcastOecLiveShoppingMessage, isHistory bool) ShoppingEvent {
+	product := &Product{ID: pt.GetDetails().GetId1()}
+	if d := pt.ShopData; d != nil {
+		product.Title = d.Title
+		product.Price = d.PriceString
+		product.Image = d.ImageUrl
+		product.ShopName = d.ShopName
+		product.ShopURL = d.ShopUrl
+		if product.ShopURL == "" {
+			product.ShopURL = d.ShopUrl2
+		}
+	}
+	// The third timestamp of the timings is not known.
+	if t := pt.ShopTimings; t != nil {
+		if t.Timestamp1 != 0 {
+			product.StartsAt = unixTime(t.Timestamp1, 0)
+		}
+		if t.Timestamp2 != 0 {
+			product.EndsAt = unixTime(t.Timestamp2, 0)
+		}
+	}
+	return ShoppingEvent{
+		MessageID: pt.Common.MsgId,
+		Timestamp: pt.Common.CreateTime,
+		Product:   product,
+		isHistory: isHistory,
+	}
+}
+
+// productTracker keeps the products shown during a live in the order they were first shown.
+type productTracker struct {
+	mu       sync.Mutex
+	products []ShownProduct
+}
+
+// key identifies a product, by id when sent or else by its title and shop url.
+func (p Product) key() string {
+	if p.ID != "" {
+		return p.ID
+	}
+	return p.Title + "\x00" + p.ShopURL
+}
+
+func (l *Live) trackProduct(e ShoppingEvent) ShoppingEvent {
+	tr := &l.products
+	shown := time.UnixMilli(e.Timestamp)
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	key := e.Product.key()
+	for i := range tr.products {
+		if tr.products[i].key() == key {
+			tr.products[i].Product = *e.Product
+			tr.products[i].LastShown = shown
+			tr.products[i].Times++
+			return e
+		}
+	}
+	tr.products = append(tr.products, ShownProduct{Product: *e.Product, FirstShown: shown, LastShown: shown, Times: 1})
+	return e
+}
+
+// Products returns the products shown during the live, in the order they were first shown.
+func (l *Live) Products() []ShownProduct {
+	tr := &l.products
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return append([]ShownProduct(nil), tr.products...)
+}
@@ -0,0 +1,50 @@
+package gotiktoklive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func shoppingMessage(msgID int64, id, title string, createTime int64) *pb.WebcastOecLiveShoppingMessage {
+	return &pb.WebcastOecLiveShoppingMessage{
+		Common: &pb.Common{Method: "WebcastOecLiveShoppingMessage", MsgId: msgID, CreateTime: createTime},
+		ShopData: &pb.WebcastOecLiveShoppingMessage_LiveShoppingData{
+			Title:       title,
+			PriceString: "$55.99",
+			ImageUrl:    "https://example.com/product.png",
+			ShopUrl:     "https://example.com/shop",
+			ShopName:    "Shopify",
+		},
+		ShopTimings: &pb.TimeStampContainer{Timestamp1: 1700000000, Timestamp2: 1700000600},
+		Details:     &pb.WebcastOecLiveShoppingMessage_LiveShoppingDetails{Id1: id},
+	}
+}
+
+func TestProducts(t *testing.T) {
+	live := &Live{}
+	e, ok := parseTestMsg(t, shoppingMessage(1, "p1", "Mug", 1700000000000)).(ShoppingEvent)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, "Mug", e.Product.Title)
+	assert.Equal(t, "$55.99", e.Product.Price)
+	assert.Equal(t, time.Unix(1700000000, 0), e.Product.StartsAt)
+	assert.Equal(t, time.Unix(1700000600, 0), e.Product.EndsAt)
+
+	live.track(e)
+	live.track(parseTestMsg(t, shoppingMessage(2, "p2", "Shirt", 1700000100000)))
+	live.track(parseTestMsg(t, shoppingMessage(3, "p1", "Mug", 1700000200000)))
+
+	products := live.Products()
+	if assert.Len(t, products, 2) {
+		assert.Equal(t, "p1", products[0].ID)
+		assert.Equal(t, 2, products[0].Times)
+		assert.Equal(t, time.UnixMilli(1700000000000), products[0].FirstShown)
+		assert.Equal(t, time.UnixMilli(1700000200000), products[0].LastShown)
+		assert.Equal(t, "Shirt", products[1].Title)
+	}
+}
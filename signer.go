@@ -0,0 +1,343 @@
+package gotiktoklive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"go.uber.org/ratelimit"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// SignRequest is the webcast fetch request that needs to be signed along with the room context.
+type SignRequest struct {
+	// URL is the full webcast fetch url including the query parameters.
+	URL string
+	// RoomID is the room being fetched.
+	RoomID string
+	// ClientName is the client name the TikTok instance was created with.
+	ClientName string
+	// Streams is the number of lives currently tracked by the TikTok instance.
+	Streams int
+}
+
+// Signer signs webcast fetch requests. The returned body must be the protobuf encoded WebcastResponse and the header
+// should carry X-Set-TT-Cookie when the signer provides one.
+//
+// Implementations can be self-hosted signers, caching signers or test doubles and are set with WithSigner. ctx is the
+// context of the call that needs the signature and should cancel any request to the signer.
+type Signer interface {
+	Sign(ctx context.Context, req *SignRequest) ([]byte, http.Header, error)
+}
+
+// SignerFunc adapts a function to a Signer.
+type SignerFunc func(ctx context.Context, req *SignRequest) ([]byte, http.Header, error)
+
+// Sign implements Signer.
+func (f SignerFunc) Sign(ctx context.Context, req *SignRequest) ([]byte, http.Header, error) {
+	return f(ctx, req)
+}
+
+// tiktokBinder is implemented by signers that need the TikTok instance, such as its http client and proxy, before
+// they can be used. bind is called once all options are applied.
+type tiktokBinder interface {
+	bind(t *TikTok) error
+}
+
+// EulerSigner signs requests with a signer that supports the signing api as defined by
+// https://www.eulerstream.com/docs/openapi. This is the default signer.
+type EulerSigner struct {
+	url     string
+	apiKey  string
+	timeout time.Duration
+	t       *TikTok
+	limiter ratelimit.Limiter
+	quota   *quotaManager
+}
+
+// NewEulerSigner creates a signer for the given signer url and api key, the api key may be empty.
+func NewEulerSigner(url, apiKey string) *EulerSigner {
+	return &EulerSigner{
+		url:    url,
+		apiKey: apiKey,
+	}
+}
+
+func (e *EulerSigner) bind(t *TikTok) error {
+	e.t = t
+	var fetch func(ctx context.Context) (SigningLimits, error)
+	if t.getLimits {
+		fetch = func(ctx context.Context) (SigningLimits, error) {
+			return GetSignerLimitsCtx(ctx, e.url, e.apiKey)
+		}
+	} else {
+		slog.Debug("Request limits set to sane default of 10 per minute, for more enable GetLimits option to use signer specified limits")
+	}
+	quota, err := newQuotaManager(t.ctx, e.url, fetch)
+	// Without the limits the quota uses the default ones, so the signer can still be used.
+	e.quota = quota
+	e.limiter = newSignLimiter(quota.snapshot().Minute.Max)
+	if err != nil {
+		return fmt.Errorf("%w of %s, using %d signs per minute until they can be fetched: %w", ErrSignerLimits, e.url, defaultSignsPerMinute, err)
+	}
+	limits := quota.snapshot()
+	slog.Debug("limits found", "signer", e.url, "day", limits.Day, "hour", limits.Hour, "minute", limits.Minute)
+	return nil
+}
+
+// newSignLimiter paces signing to perMinute requests a minute, a perMinute of 0 is not limited.
+func newSignLimiter(perMinute int) ratelimit.Limiter {
+	if perMinute <= 0 {
+		return ratelimit.NewUnlimited()
+	}
+	return ratelimit.New(perMinute, ratelimit.Per(1*time.Minute), ratelimit.WithoutSlack)
+}
+
+func (e *EulerSigner) quotas() []SignerQuota {
+	if e.quota == nil {
+		return nil
+	}
+	return []SignerQuota{e.quota.snapshot()}
+}
+
+// Sign implements Signer.
+func (e *EulerSigner) Sign(ctx context.Context, req *SignRequest) ([]byte, http.Header, error) {
+	if e.t == nil {
+		return nil, nil, errors.New("euler signer is not used by a TikTok instance, add it with WithSigner")
+	}
+	query := map[string]string{
+		"client":  req.ClientName,
+		"uuc":     strconv.Itoa(req.Streams),
+		"url":     req.URL,
+		"room_id": req.RoomID,
+	}
+	if e.apiKey != "" {
+		query["apiKey"] = e.apiKey
+	}
+	// A badly formed implementation using this library might spam connection requests (ask me
+	// how I know) this limiter is a safety guard to never go over the signer's advertised
+	// capabilities so the client does not exceed limits or get banned from the signer. The
+	// quota reports when a window is used up instead of waiting for it to reset.
+	if err := e.quota.take(ctx); err != nil {
+		return nil, nil, err
+	}
+	e.limiter.Take()
+	body, header, err := e.t.sendRequest(ctx, &reqOptions{
+		URI:      e.url,
+		Endpoint: urlSignReq,
+		Query:    query,
+		Timeout:  e.timeout,
+	}, nil)
+	if errors.Is(err, ErrRateLimitExceeded) {
+		e.quota.markStale()
+	}
+	if err != nil {
+		return nil, nil, pkgerrors.Wrap(err, fmt.Sprintf("Failed to sign request: %s", body))
+	}
+	return body, header, nil
+}
+
+// StubSigner returns a pre-baked WebcastResponse for every request without contacting any signer. It is meant for
+// tests and for replaying known room data.
+type StubSigner struct {
+	body   []byte
+	header http.Header
+}
+
+// NewStubSigner creates a signer that always answers with rsp. header may be nil.
+func NewStubSigner(rsp *pb.WebcastResponse, header http.Header) (*StubSigner, error) {
+	body, err := proto.Marshal(rsp)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal stub response: %w", err)
+	}
+	if header == nil {
+		header = http.Header{}
+	}
+	return &StubSigner{
+		body:   body,
+		header: header,
+	}, nil
+}
+
+// Sign implements Signer.
+func (s *StubSigner) Sign(context.Context, *SignRequest) ([]byte, http.Header, error) {
+	return append([]byte(nil), s.body...), s.header.Clone(), nil
+}
+
+// SignerEndpoint is a signer that supports the Euler signing api, used with SigningEndpoints.
+type SignerEndpoint struct {
+	URL    string
+	ApiKey string
+	// Timeout for a signing request, defaults to 10 seconds when zero.
+	Timeout time.Duration
+}
+
+// FailoverSigner tries each signer in order and fails over to the next one when a signer is rate limited or out of
+// quota, answers with a 5xx status, blocks the IP or times out. A signer that failed is put in a cooldown and skipped until it ends,
+// unless every signer is cooling down.
+type FailoverSigner struct {
+	signers  []Signer
+	cooldown time.Duration
+
+	mu    sync.Mutex
+	until []time.Time
+	bound []bool
+	t     *TikTok
+}
+
+// NewFailoverSigner creates a signer trying signers in the given order, failed signers are skipped for cooldown.
+func NewFailoverSigner(cooldown time.Duration, signers ...Signer) *FailoverSigner {
+	return &FailoverSigner{
+		signers:  signers,
+		cooldown: cooldown,
+		until:    make([]time.Time, len(signers)),
+		bound:    make([]bool, len(signers)),
+	}
+}
+
+// bind binds every signer that needs it. A signer failing to bind, e.g. when its limits cannot be fetched, starts in a
+// cooldown and is bound again once that ends. Only when no signer can be bound an error is returned.
+func (f *FailoverSigner) bind(t *TikTok) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.t = t
+	var errs []error
+	for i := range f.signers {
+		if err := f.bindLocked(i); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(f.signers) {
+		return fmt.Errorf("no signer available: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (f *FailoverSigner) bindLocked(i int) error {
+	b, ok := f.signers[i].(tiktokBinder)
+	if !ok || f.bound[i] {
+		f.bound[i] = true
+		return nil
+	}
+	if err := b.bind(f.t); err != nil {
+		f.until[i] = time.Now().Add(f.cooldown)
+		f.t.warnHandler(fmt.Errorf("signer %d unavailable, cooling down for %s: %w", i, f.cooldown, err))
+		return err
+	}
+	f.bound[i] = true
+	return nil
+}
+
+// order returns the signer indexes to try, signers that are not cooling down first.
+func (f *FailoverSigner) order() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	var healthy, cooling []int
+	for i := range f.signers {
+		if now.Before(f.until[i]) {
+			cooling = append(cooling, i)
+			continue
+		}
+		if f.t != nil {
+			if err := f.bindLocked(i); err != nil {
+				cooling = append(cooling, i)
+				continue
+			}
+		}
+		healthy = append(healthy, i)
+	}
+	sort.Slice(cooling, func(a, b int) bool {
+		return f.until[cooling[a]].Before(f.until[cooling[b]])
+	})
+	for _, i := range cooling {
+		if f.bound[i] {
+			healthy = append(healthy, i)
+		}
+	}
+	return healthy
+}
+
+func (f *FailoverSigner) quotas() []SignerQuota {
+	var quotas []SignerQuota
+	for _, signer := range f.signers {
+		if r, ok := signer.(quotaReporter); ok {
+			quotas = append(quotas, r.quotas()...)
+		}
+	}
+	return quotas
+}
+
+// Sign implements Signer.
+func (f *FailoverSigner) Sign(ctx context.Context, req *SignRequest) ([]byte, http.Header, error) {
+	var errs []error
+	for _, i := range f.order() {
+		body, header, err := f.signers[i].Sign(ctx, req)
+		if err == nil {
+			return body, header, nil
+		}
+		// The caller giving up says nothing about the health of the signer.
+		if ctx.Err() != nil || !shouldFailover(err) {
+			return nil, nil, err
+		}
+		until := time.Now().Add(f.cooldown)
+		var exhausted *ErrSignerQuotaExhausted
+		if errors.As(err, &exhausted) && exhausted.ResetAt.After(until) {
+			until = exhausted.ResetAt
+		}
+		f.mu.Lock()
+		f.until[i] = until
+		f.mu.Unlock()
+		if f.t != nil {
+			f.t.warnHandler(fmt.Errorf("signer %d failed, cooling down for %s: %w", i, f.cooldown, err))
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, nil, errors.New("no signer available")
+	}
+	return nil, nil, fmt.Errorf("all signers failed: %w", errors.Join(errs...))
+}
+
+// shouldFailover tells if the error means the signer is unhealthy rather than the request being bad.
+func shouldFailover(err error) bool {
+	if errors.Is(err, ErrRateLimitExceeded) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var blocked *ErrIPBlockedOrBanned
+	if errors.As(err, &blocked) {
+		return true
+	}
+	var exhausted *ErrSignerQuotaExhausted
+	if errors.As(err, &exhausted) {
+		return true
+	}
+	var status *ErrBadStatusCode
+	if errors.As(err, &status) && status.StatusCode >= 500 {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func (t *TikTok) signURL(ctx context.Context, reqUrl string, options *reqOptions) ([]byte, http.Header, error) {
+	t.mu.Lock()
+	streams := t.streams
+	t.mu.Unlock()
+	return t.signer.Sign(ctx, &SignRequest{
+		URL:        reqUrl,
+		RoomID:     options.Query["room_id"],
+		ClientName: t.clientName,
+		Streams:    streams,
+	})
+}
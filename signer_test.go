@@ -0,0 +1,126 @@
+package gotiktoklive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func TestStubSigner(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	stub, err := NewStubSigner(&pb.WebcastResponse{
+		Cursor:         "1",
+		PushServer:     srv.PushURL(),
+		RouteParamsMap: map[string]string{"im_path": "/"},
+		Messages:       []*pb.WebcastResponse_Message{webcasttest.NewMessage(chatMessage(1, "stubbed"))},
+	}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var requests []*SignRequest
+	signer := SignerFunc(func(ctx context.Context, req *SignRequest) ([]byte, http.Header, error) {
+		requests = append(requests, req)
+		return stub.Sign(ctx, req)
+	})
+
+	tiktok, err := NewTikTokWithApiKey("stub-client", "", TikTokUrl(srv.TikTokURL()), WebcastUrl(srv.WebcastURL()), WithSigner(signer))
+	if !assert.NoError(t, err) {
+		return
+	}
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+
+	chat := nextEvent[ChatEvent](t, live.Events)
+	assert.Equal(t, "stubbed", chat.Comment)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, srv.RoomID(), requests[0].RoomID)
+		assert.Equal(t, "stub-client", requests[0].ClientName)
+		assert.Equal(t, 1, requests[0].Streams)
+		assert.Contains(t, requests[0].URL, srv.WebcastURL()+urlRoomData)
+	}
+}
+
+func TestWithSignerNil(t *testing.T) {
+	_, err := NewTikTok(WithSigner(nil))
+	assert.Error(t, err)
+}
+
+func TestSigningEndpointsFailover(t *testing.T) {
+	broken := webcasttest.NewServer(webcasttest.WithSignerStatus(http.StatusBadGateway))
+	defer broken.Close()
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv, SigningEndpoints(
+		SignerEndpoint{URL: broken.SignerURL()},
+		SignerEndpoint{URL: srv.SignerURL()},
+	))
+
+	for i := 0; i < 2; i++ {
+		live, err := tiktok.TrackRoom(srv.RoomID())
+		if !assert.NoError(t, err) {
+			return
+		}
+		live.Close()
+	}
+	assert.Len(t, broken.FetchCursors(), 1, "broken signer should be cooling down after the first failure")
+	assert.Len(t, srv.FetchCursors(), 2)
+}
+
+func TestSigningEndpointsLimitsDown(t *testing.T) {
+	down := webcasttest.NewServer()
+	down.Close()
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	tiktok := newTestTikTok(t, srv, SigningEndpoints(
+		SignerEndpoint{URL: down.SignerURL()},
+		SignerEndpoint{URL: srv.SignerURL()},
+	))
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err, "a signer without limits cools down while the others sign") {
+		return
+	}
+	live.Close()
+	assert.Len(t, srv.FetchCursors(), 1)
+
+	// A single signer without limits still signs with the default limits.
+	single := newTestTikTok(t, srv, SigningUrl(down.SignerURL()))
+	quotas := single.SignerQuota()
+	if assert.Len(t, quotas, 1) {
+		assert.Equal(t, defaultSignsPerMinute, quotas[0].Minute.Max)
+	}
+}
+
+func TestShouldFailover(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		failover bool
+	}{
+		"rate limited":   {err: ErrRateLimitExceeded, failover: true},
+		"blocked":        {err: &ErrIPBlockedOrBanned{}, failover: true},
+		"bad gateway":    {err: &ErrBadStatusCode{StatusCode: http.StatusBadGateway}, failover: true},
+		"timeout":        {err: context.DeadlineExceeded, failover: true},
+		"bad request":    {err: &ErrBadStatusCode{StatusCode: http.StatusBadRequest}, failover: false},
+		"wrapped 5xx":    {err: fmt.Errorf("sign: %w", &ErrBadStatusCode{StatusCode: 503}), failover: true},
+		"unknown errors": {err: errors.New("boom"), failover: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(tt *testing.T) {
+			assert.Equal(tt, test.failover, shouldFailover(test.err))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package gotiktoklive
+
+import (
+	"strconv"
+	"strings"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// DisplayText is a text TikTok renders from a pattern such as "{0:user} joined the fan club" and a list of pieces
+// filling its placeholders.
+type DisplayText struct {
+	Key     string
+	Pattern string
+	// Text is the pattern with all placeholders replaced by their pieces.
+	Text string
+	// Format is the format of the pattern, pieces can have their own.
+	Format TextFormat
+	Pieces []TextPiece
+	// Segments is Text split into runs of a single format, to render it as TikTok shows it.
+	Segments []TextSegment
+}
+
+// TextPiece is a value of a DisplayText placeholder, User or GiftID tell what the piece refers to. Text is empty when
+// TikTok did not send it, such as a gift without its name, and the placeholder is left out.
+type TextPiece struct {
+	Text   string
+	User   *User
+	GiftID int
+	// Format is the format of the piece, the format of the DisplayText when the piece has none.
+	Format TextFormat
+}
+
+// TextFormat is how TikTok styles a part of a DisplayText. Color is a hex color such as "#FFFF8E8E", empty for the
+// default color.
+type TextFormat struct {
+	Color             string
+	Bold              bool
+	Italic            bool
+	Weight            int
+	ItalicAngle       int
+	FontSize          int
+	UseHighlightColor bool
+	UseRemoteColor    bool
+}
+
+// TextSegment is a run of a DisplayText with a single format. Piece is the piece the segment was rendered from, nil
+// for the text of the pattern itself.
+type TextSegment struct {
+	Text   string
+	Format TextFormat
+	Piece  *TextPiece
+}
+
+func (d DisplayText) String() string {
+	return d.Text
+}
+
+func toDisplayText(t *pb.Text) DisplayText {
+	if t == nil {
+		return DisplayText{}
+	}
+	d := DisplayText{Key: t.Key, Pattern: t.DefaultPattern, Format: toTextFormat(t.DefaultFormat, TextFormat{})}
+	for _, p := range t.PiecesList {
+		d.Pieces = append(d.Pieces, toTextPiece(p, d.Format))
+	}
+	d.Segments = renderSegments(d.Pattern, d.Format, d.Pieces)
+	d.Text = segmentsText(d.Segments)
+	return d
+}
+
+func toTextPiece(p *pb.Text_TextPiece, format TextFormat) TextPiece {
+	format = toTextFormat(p.Format, format)
+	switch {
+	case p.GetUserValue() != nil:
+		piece := TextPiece{User: toUser(p.GetUserValue().User), Format: format}
+		piece.Text = piece.User.Nickname
+		if piece.Text == "" {
+			piece.Text = piece.User.Username
+		}
+		if p.GetUserValue().WithColon {
+			piece.Text += ":"
+		}
+		return piece
+	case p.GetGiftValue() != nil:
+		gift := p.GetGiftValue()
+		return TextPiece{Text: gift.GetNameRef().GetDefaultPattern(), GiftID: int(gift.GiftId), Format: format}
+	case p.PatternRefValue != nil:
+		return TextPiece{Text: p.PatternRefValue.DefaultPattern, Format: format}
+	}
+	return TextPiece{Text: p.StringValue, Format: format}
+}
+
+// toTextFormat converts f, returning def when there is none.
+func toTextFormat(f *pb.Text_TextFormat, def TextFormat) TextFormat {
+	if f == nil {
+		return def
+	}
+	return TextFormat{
+		Color:             f.Color,
+		Bold:              f.Bold,
+		Italic:            f.Italic,
+		Weight:            int(f.Weight),
+		ItalicAngle:       int(f.ItalicAngle),
+		FontSize:          int(f.FontSize),
+		UseHighlightColor: f.UseHeighLightColor,
+		UseRemoteColor:    f.UseRemoteClor,
+	}
+}
+
+// renderSegments replaces the placeholders of pattern, "{<index>}" or "{<index>:<type>}", with the piece at that
+// index, split into the text of the pattern in format and the pieces in their own format. Placeholders without a
+// piece are left out.
+func renderSegments(pattern string, format TextFormat, pieces []TextPiece) []TextSegment {
+	var segments []TextSegment
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			segments = append(segments, TextSegment{Text: literal.String(), Format: format})
+			literal.Reset()
+		}
+	}
+	for {
+		start := strings.IndexByte(pattern, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(pattern[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		literal.WriteString(pattern[:start])
+		ref := pattern[start+1 : end]
+		if colon := strings.IndexByte(ref, ':'); colon >= 0 {
+			ref = ref[:colon]
+		}
+		if i, err := strconv.Atoi(ref); err == nil {
+			if i >= 0 && i < len(pieces) && pieces[i].Text != "" {
+				flush()
+				segments = append(segments, TextSegment{Text: pieces[i].Text, Format: pieces[i].Format, Piece: &pieces[i]})
+			}
+		} else {
+			// Not a placeholder, keep it as is.
+			literal.WriteString(pattern[start : end+1])
+		}
+		pattern = pattern[end+1:]
+	}
+	literal.WriteString(pattern)
+	flush()
+	return segments
+}
+
+func segmentsText(segments []TextSegment) string {
+	var b strings.Builder
+	for _, s := range segments {
+		b.WriteString(s.Text)
+	}
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package gotiktoklive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+func TestRenderSegments(t *testing.T) {
+	pieces := []TextPiece{{Text: "fan"}, {Text: "Rose"}}
+	tests := map[string]struct {
+		pattern, want string
+	}{
+		"typed placeholders": {"{0:user} sent {1:gift}", "fan sent Rose"},
+		"bare placeholders":  {"{0} sent {1}", "fan sent Rose"},
+		"missing piece":      {"{0:user} sent {2:string}", "fan sent "},
+		"not a placeholder":  {"{name} {0:user}", "{name} fan"},
+		"unterminated":       {"{0:user} {1", "fan {1"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, segmentsText(renderSegments(test.pattern, TextFormat{}, pieces)))
+		})
+	}
+}
+
+func TestDisplayTextGiftName(t *testing.T) {
+	text := func(gift *pb.Text_TextPieceGift) *pb.Text {
+		return &pb.Text{
+			DefaultPattern: "{0:user} sent {1:gift}",
+			PiecesList: []*pb.Text_TextPiece{
+				{TextPieceType: &pb.Text_TextPiece_UserValue{UserValue: &pb.Text_TextPieceUser{User: &pb.User{Nickname: "fan"}}}},
+				{TextPieceType: &pb.Text_TextPiece_GiftValue{GiftValue: gift}},
+			},
+		}
+	}
+	d := toDisplayText(text(&pb.Text_TextPieceGift{GiftId: 5655, NameRef: &pb.Text_TextPiecePatternRef{DefaultPattern: "Rose"}}))
+	assert.Equal(t, "fan sent Rose", d.Text)
+	assert.Equal(t, 5655, d.Pieces[1].GiftID)
+
+	// A gift without its name is left out.
+	d = toDisplayText(text(&pb.Text_TextPieceGift{GiftId: 5655}))
+	assert.Equal(t, "fan sent ", d.Text)
+	assert.Len(t, d.Segments, 2)
+}
+
+func TestDisplayTextSegments(t *testing.T) {
+	d := toDisplayText(&pb.Text{
+		DefaultPattern: "{0:user} sent {1:string} roses",
+		DefaultFormat:  &pb.Text_TextFormat{Color: "#FFFFFFFF"},
+		PiecesList: []*pb.Text_TextPiece{
+			{
+				Format:        &pb.Text_TextFormat{Color: "#FFFF8E8E", Bold: true},
+				TextPieceType: &pb.Text_TextPiece_UserValue{UserValue: &pb.Text_TextPieceUser{User: &pb.User{Id: 2, Nickname: "fan"}}},
+			},
+			{StringValue: "5"},
+		},
+	})
+	assert.Equal(t, "fan sent 5 roses", d.Text)
+	if !assert.Len(t, d.Segments, 4) {
+		return
+	}
+	assert.Equal(t, "fan", d.Segments[0].Text)
+	assert.Equal(t, TextFormat{Color: "#FFFF8E8E", Bold: true}, d.Segments[0].Format)
+	assert.Equal(t, int64(2), d.Segments[0].Piece.User.ID)
+	assert.Equal(t, TextSegment{Text: " sent ", Format: TextFormat{Color: "#FFFFFFFF"}}, d.Segments[1])
+	// Pieces without a format use the format of the pattern.
+	assert.Equal(t, "5", d.Segments[2].Text)
+	assert.Equal(t, TextFormat{Color: "#FFFFFFFF"}, d.Segments[2].Format)
+	assert.Nil(t, d.Segments[3].Piece)
+}
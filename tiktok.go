@@ -7,9 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"go.uber.org/ratelimit"
 	"io"
-	"log/slog"
 	"maps"
 	"net/http"
 	"net/http/cookiejar"
@@ -24,16 +22,29 @@ import (
 )
 
 const (
-	defaultSignerURL = "https://tiktok.eulerstream.com"
+	defaultSignerURL      = "https://tiktok.eulerstream.com"
+	defaultSignerTimeout  = 10 * time.Second
+	defaultSignerCooldown = 1 * time.Minute
+
+	defaultPingInterval = 10 * time.Second
+
+	defaultReconnectDelay       = 1 * time.Second
+	defaultReconnectMaxDelay    = 1 * time.Minute
+	defaultReconnectMaxAttempts = 10
 )
 
 // TikTok allows you to track and discover current live streams.
 type TikTok struct {
-	c    *http.Client
-	wg   *sync.WaitGroup
-	done func() <-chan struct{}
+	c      *http.Client
+	wg     *sync.WaitGroup
+	ctx    context.Context
+	done   func() <-chan struct{}
+	cancel context.CancelFunc
+	closed chan struct{}
+	once   *sync.Once
 
 	streams int
+	lives   map[*Live]struct{}
 	mu      *sync.Mutex
 
 	// Pass extra debug messages to debugHandler
@@ -51,15 +62,26 @@ type TikTok struct {
 	apiKey                   string
 	clientName               string
 	shouldReconnect          bool
+	reconnectDelay           time.Duration
+	reconnectMaxDelay        time.Duration
+	reconnectMaxAttempts     int
 	enableExperimentalEvents bool
 	enableExtraDebug         bool
 	enableWSTrace            bool
 	wsTraceFile              string
 	wsTraceChan              chan struct{ direction, hex string }
 	wsTraceOut               *bufio.Writer
+	handleInterrupts         bool
+	dedupTTL                 time.Duration
+	dedupCapacity            int
+	recordDir                string
+	chatBuffer               int
 	signerUrl                string
+	baseUrl                  string
+	apiUrl                   string
+	pingInterval             time.Duration
 	getLimits                bool
-	limiter                  ratelimit.Limiter
+	signer                   Signer
 }
 
 // NewTikTok creates a tiktok instance that allows you to track live streams and
@@ -84,17 +106,31 @@ func NewTikTokWithApiKey(clientName, apiKey string, options ...TikTokLiveOption)
 			// Transport: &loggingTransport{},
 		},
 		wg:              &wg,
+		ctx:             ctx,
 		done:            ctx.Done,
+		cancel:          cancel,
+		closed:          make(chan struct{}),
+		once:            &sync.Once{},
+		lives:           make(map[*Live]struct{}),
 		mu:              &sync.Mutex{},
 		infoHandler:     defaultLogHandler,
 		warnHandler:     defaultLogHandler,
 		debugHandler:    routineErrHandler,
 		errHandler:      routineErrHandler,
 		signerUrl:       defaultSignerURL,
+		baseUrl:         tiktokBaseUrl,
+		apiUrl:          tiktokAPIUrl,
+		pingInterval:    defaultPingInterval,
 		clientName:      clientName,
 		apiKey:          apiKey,
 		shouldReconnect: true,
 		getLimits:       true,
+
+		reconnectDelay:       defaultReconnectDelay,
+		reconnectMaxDelay:    defaultReconnectMaxDelay,
+		reconnectMaxAttempts: defaultReconnectMaxAttempts,
+		dedupTTL:             defaultDedupTTL,
+		dedupCapacity:        defaultDedupCapacity,
 	}
 	envs := []string{"HTTP_PROXY", "HTTPS_PROXY"}
 	var optionsErr []error
@@ -112,19 +148,17 @@ func NewTikTokWithApiKey(clientName, apiKey string, options ...TikTokLiveOption)
 		cancel()
 		return nil, err
 	}
-	if tiktok.getLimits {
-		limits, err := GetSignerLimits(tiktok.signerUrl, tiktok.apiKey)
-		if err != nil {
+	if tiktok.signer == nil {
+		tiktok.signer = NewEulerSigner(tiktok.signerUrl, tiktok.apiKey)
+	}
+	if b, ok := tiktok.signer.(tiktokBinder); ok {
+		// A signer without its limits still signs with the default limits.
+		if err := b.bind(&tiktok); errors.Is(err, ErrSignerLimits) {
+			tiktok.warnHandler(err)
+		} else if err != nil {
 			cancel()
-			return nil, fmt.Errorf("cannot get signing limits: %w", err)
+			return nil, err
 		}
-		slog.Debug("limits found, using per minute limit", "day", limits.Day, "hour", limits.Hour, "minute", limits.Minute)
-		limiter := ratelimit.New(limits.Minute.Max, ratelimit.Per(1*time.Minute), ratelimit.WithoutSlack)
-		tiktok.limiter = limiter
-	} else {
-		slog.Debug("Request limits set to sane default of 10 per minute, for more enable GetLimits option to use signer specified limits")
-		limiter := ratelimit.New(10, ratelimit.Per(1*time.Minute), ratelimit.WithoutSlack)
-		tiktok.limiter = limiter
 	}
 
 	if tiktok.enableWSTrace {
@@ -136,6 +170,11 @@ func NewTikTokWithApiKey(clientName, apiKey string, options ...TikTokLiveOption)
 			goto continueSetup
 		}
 		f, err := os.Create(tiktok.wsTraceFile)
+		if err != nil {
+			tiktok.errHandler(fmt.Errorf("cannot create ws trace file, it will not be enable: %w", err))
+			tiktok.enableWSTrace = false
+			goto continueSetup
+		}
 		tiktok.wsTraceOut = bufio.NewWriter(f)
 
 		wg.Add(1)
@@ -144,34 +183,49 @@ func NewTikTokWithApiKey(clientName, apiKey string, options ...TikTokLiveOption)
 				_ = f.Close()
 			}()
 			defer wg.Done()
+			write := func(t struct{ direction, hex string }) {
+				timestamp := time.Now().UTC().Format(traceTimeLayout)
+				tiktok.wsTraceOut.Write([]byte(timestamp))
+				tiktok.wsTraceOut.Write([]byte(t.direction))
+				tiktok.wsTraceOut.Write([]byte(" "))
+				tiktok.wsTraceOut.Write([]byte(t.hex))
+				tiktok.wsTraceOut.Write([]byte("\n"))
+			}
 			for {
 				select {
 				case <-ctx.Done():
-					return
+					// Lives are closed before the root context, keep what they traced last.
+					for {
+						select {
+						case t := <-tiktok.wsTraceChan:
+							write(t)
+						default:
+							if err := tiktok.wsTraceOut.Flush(); err != nil {
+								tiktok.errHandler(fmt.Errorf("cannot flush ws trace: %w", err))
+							}
+							return
+						}
+					}
 				case t := <-tiktok.wsTraceChan:
-					timestamp := time.Now().UTC().Format("2006-01-02 15:04:05.000")
-					tiktok.wsTraceOut.Write([]byte(timestamp))
-					tiktok.wsTraceOut.Write([]byte(t.direction))
-					tiktok.wsTraceOut.Write([]byte(" "))
-					tiktok.wsTraceOut.Write([]byte(t.hex))
-					tiktok.wsTraceOut.Write([]byte("\n"))
+					write(t)
 					tiktok.wsTraceOut.Flush()
 				}
 			}
 		}()
 	}
 continueSetup:
-	setupInterruptHandler(
-		func(c chan os.Signal) {
-			<-c
-			cancel()
-			wg.Wait()
-
-			tiktok.infoHandler("Shutting down...")
-			os.Exit(0)
-		})
+	if tiktok.handleInterrupts {
+		setupInterruptHandler(ctx,
+			func() {
+				tiktok.infoHandler("Shutting down...")
+				if err := tiktok.Close(context.Background()); err != nil {
+					tiktok.errHandler(err)
+				}
+				os.Exit(0)
+			})
+	}
 
-	tiktok.sendRequest(&reqOptions{
+	tiktok.sendRequest(ctx, &reqOptions{
 		OmitAPI: true,
 	}, nil)
 
@@ -182,8 +236,13 @@ continueSetup:
 // information about the user and also the live room which contains their user ID, as well
 // as the RoomID, with which you can tell if they are live.
 func (t *TikTok) GetLiveRoomUserInfo(user string) (LiveRoomUserInfo, error) {
+	return t.GetLiveRoomUserInfoCtx(context.Background(), user)
+}
+
+// GetLiveRoomUserInfoCtx is GetLiveRoomUserInfo with a context to cancel the request.
+func (t *TikTok) GetLiveRoomUserInfoCtx(ctx context.Context, user string) (LiveRoomUserInfo, error) {
 	user = cleanupUser(user)
-	body, _, err := t.sendRequest(&reqOptions{
+	body, _, err := t.sendRequest(ctx, &reqOptions{
 		Endpoint: fmt.Sprintf(urlUser+urlLive, user),
 		Query:    defaultRequestHeaders,
 		OmitAPI:  true,
@@ -232,7 +291,12 @@ func cleanupUser(user string) string {
 //
 //	their user ID, as well as the RoomID, with which you can tell if they are live.
 func (t *TikTok) GetUserInfo(user string) (LiveRoomUser, error) {
-	roomUserInfo, err := t.GetLiveRoomUserInfo(user)
+	return t.GetUserInfoCtx(context.Background(), user)
+}
+
+// GetUserInfoCtx is GetUserInfo with a context to cancel the request.
+func (t *TikTok) GetUserInfoCtx(ctx context.Context, user string) (LiveRoomUser, error) {
+	roomUserInfo, err := t.GetLiveRoomUserInfoCtx(ctx, user)
 	if err != nil {
 		return LiveRoomUser{}, err
 	}
@@ -247,7 +311,12 @@ func (t *TikTok) GetUserInfo(user string) (LiveRoomUser, error) {
 //
 //	different country.
 func (t *TikTok) GetPriceList() (*PriceList, error) {
-	body, _, err := t.sendRequest(&reqOptions{
+	return t.GetPriceListCtx(context.Background())
+}
+
+// GetPriceListCtx is GetPriceList with a context to cancel the request.
+func (t *TikTok) GetPriceListCtx(ctx context.Context) (*PriceList, error) {
+	body, _, err := t.sendRequest(ctx, &reqOptions{
 		Endpoint: urlPriceList,
 		Query:    defaultGETParams,
 	}, nil)
@@ -317,6 +386,11 @@ func (t *TikTok) setProxy(url string, insecure bool) error {
 // user is not found that means there was never a live by that user in the first
 // place.
 func (t *TikTok) IsLive(info LiveRoomUserInfo) (bool, error) {
+	return t.IsLiveCtx(context.Background(), info)
+}
+
+// IsLiveCtx is IsLive with a context to cancel the request.
+func (t *TikTok) IsLiveCtx(ctx context.Context, info LiveRoomUserInfo) (bool, error) {
 	minGetParams := maps.Clone(minGetParams)
 	minGetParams["room_ids"] = info.LiveRoomUser.RoomID
 
@@ -336,7 +410,7 @@ func (t *TikTok) IsLive(info LiveRoomUserInfo) (bool, error) {
 		StatusCode int        `json:"status_code"`
 	}
 
-	body, _, err := t.sendRequest(&reqOptions{
+	body, _, err := t.sendRequest(ctx, &reqOptions{
 		Endpoint: urlCheckLive,
 		Query:    minGetParams,
 		OmitAPI:  false,
@@ -357,10 +431,46 @@ func (t *TikTok) IsLive(info LiveRoomUserInfo) (bool, error) {
 	return false, fmt.Errorf("roomID not found in result")
 }
 
-func setupInterruptHandler(f func(chan os.Signal)) {
-	c := make(chan os.Signal)
+// setupInterruptHandler calls f on the first SIGINT or SIGTERM, until ctx is done.
+func setupInterruptHandler(ctx context.Context, f func()) {
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-	go f(c)
+	go func() {
+		defer signal.Stop(c)
+		select {
+		case <-c:
+			f()
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// Close stops the TikTok instance: every tracked Live is closed, the root context is cancelled, the websocket trace
+// is flushed and all background routines are waited for. If ctx is done first its error is returned and the shutdown
+// continues in the background. Requests made after Close fail with ErrClosed.
+func (t *TikTok) Close(ctx context.Context) error {
+	t.once.Do(func() {
+		go func() {
+			defer close(t.closed)
+			t.mu.Lock()
+			lives := make([]*Live, 0, len(t.lives))
+			for live := range t.lives {
+				lives = append(lives, live)
+			}
+			t.mu.Unlock()
+			for _, live := range lives {
+				live.Close()
+			}
+			t.cancel()
+			t.wg.Wait()
+		}()
+	})
+	select {
+	case <-t.closed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // GetSignerLimits returns the limits as provided by the signer.  This supports eulerstream signer and any other signers that
@@ -371,7 +481,16 @@ func setupInterruptHandler(f func(chan os.Signal)) {
 //	limits, _ := GetSignerLimits("https://tiktok.eulerstream.com", "MyApiKey")
 //	fmt.Printf("limits Day: %d, Hour: %d, Minutes %d\n", limits.Day, limits.Hour, limits.Minute)
 func GetSignerLimits(signer string, apiKey string) (SigningLimits, error) {
-	resp, err := http.Get(fmt.Sprintf("%s/webcast/rate_limits?apiKey=%s", signer, apiKey))
+	return GetSignerLimitsCtx(context.Background(), signer, apiKey)
+}
+
+// GetSignerLimitsCtx is GetSignerLimits with a context to cancel the request.
+func GetSignerLimitsCtx(ctx context.Context, signer string, apiKey string) (SigningLimits, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/webcast/rate_limits?apiKey=%s", signer, apiKey), nil)
+	if err != nil {
+		return SigningLimits{}, fmt.Errorf("cannot get rate_limts: %s", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return SigningLimits{}, fmt.Errorf("cannot get rate_limts: %s", err)
 	}
@@ -0,0 +1,51 @@
+package gotiktoklive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func TestTikTokClose(t *testing.T) {
+	srv := webcasttest.NewServer()
+	defer srv.Close()
+
+	trace := filepath.Join(t.TempDir(), "trace.log")
+	tiktok := newTestTikTok(t, srv, EnableWSTrace(trace))
+	live, err := tiktok.TrackRoom(srv.RoomID())
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, srv.WaitForConnection(5*time.Second)) {
+		return
+	}
+	if !assert.NoError(t, srv.PushMessages(webcasttest.NewMessage(chatMessage(5001, "before close")))) {
+		return
+	}
+	nextEvent[ChatEvent](t, live.Events)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if !assert.NoError(t, tiktok.Close(ctx)) {
+		return
+	}
+	nextEvent[*DisconnectEvent](t, live.Events)
+	_, ok := <-live.Events
+	assert.False(t, ok, "events should be closed")
+	assert.NoError(t, tiktok.Close(ctx), "closing twice")
+
+	b, err := os.ReadFile(trace)
+	if assert.NoError(t, err) {
+		assert.True(t, strings.Contains(string(b), "<= "), "trace should be flushed")
+	}
+
+	_, err = tiktok.TrackRoom(srv.RoomID())
+	assert.ErrorIs(t, err, ErrClosed)
+}
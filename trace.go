@@ -0,0 +1,233 @@
+package gotiktoklive
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+)
+
+// traceTimeLayout is the timestamp written at the start of every EnableWSTrace line, always in UTC.
+const traceTimeLayout = "2006-01-02 15:04:05.000"
+
+// TraceEntry is a line of a websocket trace written with EnableWSTrace.
+type TraceEntry struct {
+	Time time.Time
+	// Inbound is true for frames received from the server (<=) and false for frames sent (=>).
+	Inbound bool
+	// Data is the frame, it is empty when the line has a Note instead.
+	Data []byte
+	// Note is set for lines that do not trace a binary frame, such as "websocket closed" or an unexpected opcode.
+	Note string
+}
+
+// TraceReader reads the lines of a trace written with EnableWSTrace.
+type TraceReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewTraceReader creates a reader for the trace in r.
+func NewTraceReader(r io.Reader) *TraceReader {
+	return &TraceReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next entry of the trace, io.EOF is returned at the end. Empty lines are skipped.
+func (r *TraceReader) Next() (TraceEntry, error) {
+	for {
+		line, err := r.r.ReadString('\n')
+		if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+			return TraceEntry{}, err
+		}
+		r.line++
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			continue
+		}
+		entry, err := parseTraceLine(line)
+		if err != nil {
+			return TraceEntry{}, fmt.Errorf("trace line %d: %w", r.line, err)
+		}
+		return entry, nil
+	}
+}
+
+// parseTraceLine parses "<timestamp><direction> <payload>", where the direction is "<=", "=>" or
+// "<= (unexpected opcode 01)" and the payload is hex or a note like "websocket closed".
+func parseTraceLine(line string) (TraceEntry, error) {
+	if len(line) < len(traceTimeLayout) {
+		return TraceEntry{}, fmt.Errorf("line too short: %q", line)
+	}
+	ts, err := time.ParseInLocation(traceTimeLayout, line[:len(traceTimeLayout)], time.UTC)
+	if err != nil {
+		return TraceEntry{}, fmt.Errorf("bad timestamp: %w", err)
+	}
+	entry := TraceEntry{Time: ts}
+
+	rest := line[len(traceTimeLayout):]
+	switch {
+	case strings.HasPrefix(rest, "<="):
+		entry.Inbound = true
+	case strings.HasPrefix(rest, "=>"):
+	default:
+		return TraceEntry{}, fmt.Errorf("unknown direction in %q", rest)
+	}
+	rest = strings.TrimSpace(rest[2:])
+
+	if strings.HasPrefix(rest, "(") {
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			return TraceEntry{}, fmt.Errorf("unterminated note in %q", rest)
+		}
+		entry.Note = rest[1:end]
+		return entry, nil
+	}
+	data, err := hex.DecodeString(rest)
+	if err != nil {
+		entry.Note = rest
+		return entry, nil
+	}
+	entry.Data = data
+	return entry, nil
+}
+
+// DecodeFrame decodes a websocket frame received from the webcast push server. The response is nil for frames that do
+// not carry messages, such as heartbeats.
+func DecodeFrame(frame []byte) (*pb.WebcastPushFrame, *pb.WebcastResponse, error) {
+	var rsp pb.WebcastPushFrame
+	if err := proto.Unmarshal(frame, &rsp); err != nil {
+		return nil, nil, fmt.Errorf("Failed to unmarshal proto WebcastWebsocketMessage: %w", err)
+	}
+	if rsp.PayloadType != "msg" {
+		return &rsp, nil, nil
+	}
+	var response pb.WebcastResponse
+	if err := proto.Unmarshal(rsp.Payload, &response); err != nil {
+		return &rsp, nil, fmt.Errorf("Failed to unmarshal proto WebcastResponse: %w", err)
+	}
+	return &rsp, &response, nil
+}
+
+// ParseResponse converts the messages of a WebcastResponse into events the same way a Live does. Messages that do not
+// result in an event are skipped. The per live trackers are skipped as well, so the events they derive, such as
+// BattleEvent, are missing and tracked events are not merged with earlier ones: CoHostChangeEvent has no previous
+// states and is sent even when nothing changed, and PollEvent only has the options of its own message.
+func ParseResponse(rsp *pb.WebcastResponse, enableExperimentalEvents bool) ([]Event, error) {
+	var events []Event
+	for _, rawMsg := range rsp.GetMessages() {
+		msg, err := parseMsg(rawMsg, defaultLogHandler, routineErrHandler, enableExperimentalEvents)
+		if err != nil {
+			return events, fmt.Errorf("Failed to parse response message: %w", err)
+		}
+		if msg != nil {
+			events = append(events, msg)
+		}
+	}
+	return events, nil
+}
+
+// ConvertTrace converts a trace written with EnableWSTrace into a recording that can be played with ReplayLive. Only
+// received frames are kept. Traces hold neither the room info nor the initial room data, so the replayed Live has no
+// Info and only the websocket events. When roomID is empty it is taken from the first message in the trace. It returns
+// the number of frames written.
+func ConvertTrace(r io.Reader, w *RecordWriter, roomID string) (int, error) {
+	tr := NewTraceReader(r)
+	// Frames are held back until the room id is known as it must be the first record.
+	var pending []Record
+	frames := 0
+	flush := func() error {
+		for _, rec := range pending {
+			if err := w.Write(rec); err != nil {
+				return err
+			}
+			frames++
+		}
+		pending = nil
+		return nil
+	}
+	started := false
+	start := func(at time.Time) error {
+		if err := w.Write(Record{Kind: RecordRoomID, Time: at, Data: []byte(roomID)}); err != nil {
+			return err
+		}
+		started = true
+		return flush()
+	}
+
+	for {
+		entry, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return frames, err
+		}
+		if !entry.Inbound || entry.Note != "" {
+			continue
+		}
+		pending = append(pending, Record{Kind: RecordPushFrame, Time: entry.Time, Data: entry.Data})
+		if !started && roomID == "" {
+			roomID = frameRoomID(entry.Data)
+		}
+		if !started && roomID != "" {
+			err = start(pending[0].Time)
+		} else if started {
+			err = flush()
+		}
+		if err != nil {
+			return frames, err
+		}
+	}
+	if started {
+		return frames, nil
+	}
+	if len(pending) == 0 {
+		return 0, errors.New("trace has no received frames")
+	}
+	return frames, errors.New("room id not found in trace, set it explicitly")
+}
+
+// frameRoomID finds the room id of a frame from the Common of its first message, or returns an empty string.
+func frameRoomID(frame []byte) string {
+	_, rsp, err := DecodeFrame(frame)
+	if err != nil || rsp == nil {
+		return ""
+	}
+	for _, msg := range rsp.Messages {
+		// Every webcast message starts with its Common as field 1.
+		b := msg.Payload
+		for len(b) > 0 {
+			num, typ, n := protowire.ConsumeTag(b)
+			if n < 0 {
+				break
+			}
+			b = b[n:]
+			if num == 1 && typ == protowire.BytesType {
+				v, n := protowire.ConsumeBytes(b)
+				if n < 0 {
+					break
+				}
+				var common pb.Common
+				if proto.Unmarshal(v, &common) == nil && common.RoomId != 0 {
+					return strconv.FormatInt(common.RoomId, 10)
+				}
+				break
+			}
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				break
+			}
+			b = b[n:]
+		}
+	}
+	return ""
+}
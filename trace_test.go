@@ -0,0 +1,108 @@
+package gotiktoklive
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"github.com/steampoweredtaco/gotiktoklive/webcasttest"
+)
+
+func traceFrame(t *testing.T, msgs ...proto.Message) string {
+	t.Helper()
+	rsp := &pb.WebcastResponse{NeedsAck: true}
+	for _, m := range msgs {
+		rsp.Messages = append(rsp.Messages, webcasttest.NewMessage(m))
+	}
+	payload, err := proto.Marshal(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame, err := proto.Marshal(&pb.WebcastPushFrame{LogId: 1, PayloadType: "msg", Payload: payload})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(frame)
+}
+
+func TestTraceReader(t *testing.T) {
+	chat := chatMessage(4001, "traced")
+	chat.Common.RoomId = 7001
+	trace := strings.Join([]string{
+		"2024-05-01 10:00:00.000=> 3a026862",
+		"2024-05-01 10:00:00.250<= " + traceFrame(t, chat),
+		"",
+		"2024-05-01 10:00:01.000<= (unexpected opcode 01) 6869",
+		"2024-05-01 10:00:02.000<= websocket closed",
+	}, "\n")
+
+	tr := NewTraceReader(strings.NewReader(trace))
+	var entries []TraceEntry
+	for {
+		entry, err := tr.Next()
+		if err != nil {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	if !assert.Len(t, entries, 4) {
+		return
+	}
+	assert.False(t, entries[0].Inbound)
+	assert.Equal(t, []byte{0x3a, 0x02, 0x68, 0x62}, entries[0].Data)
+	assert.True(t, entries[1].Inbound)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 250e6, time.UTC), entries[1].Time)
+	assert.Equal(t, "unexpected opcode 01", entries[2].Note)
+	assert.Equal(t, "websocket closed", entries[3].Note)
+
+	_, rsp, err := DecodeFrame(entries[1].Data)
+	if !assert.NoError(t, err) {
+		return
+	}
+	events, err := ParseResponse(rsp, false)
+	if assert.NoError(t, err) && assert.Len(t, events, 1) {
+		assert.Equal(t, "traced", events[0].(ChatEvent).Comment)
+	}
+
+	_, err = NewTraceReader(strings.NewReader("2024-05-01 10:00:00.000 ?? 00")).Next()
+	assert.Error(t, err)
+}
+
+func TestConvertTrace(t *testing.T) {
+	first := chatMessage(4101, "first")
+	first.Common.RoomId = 7002
+	trace := fmt.Sprintf("2024-05-01 10:00:00.000<= %s\n2024-05-01 10:00:00.010=> 3a026862\n2024-05-01 10:00:00.020<= %s\n",
+		traceFrame(t, first), traceFrame(t, chatMessage(4102, "second")))
+
+	var buf bytes.Buffer
+	w, err := NewRecordWriter(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	frames, err := ConvertTrace(strings.NewReader(trace), w, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 2, frames)
+
+	live, err := ReplayLive(&buf, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer live.Close()
+	assert.Equal(t, "7002", live.ID)
+	assert.Nil(t, live.Info)
+	assert.Equal(t, "first", nextEvent[ChatEvent](t, live.Events).Comment)
+	assert.Equal(t, "second", nextEvent[ChatEvent](t, live.Events).Comment)
+	nextEvent[*DisconnectEvent](t, live.Events)
+
+	_, err = ConvertTrace(strings.NewReader(fmt.Sprintf("2024-05-01 10:00:00.000<= %s\n", traceFrame(t, chatMessage(4103, "no room")))), w, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package gotiktoklive
+
+import (
+	"time"
+)
+
+// track keeps the per live state of parsed events, such as treasure chests, goals, polls, rankings, co-hosts, battles,
+// shop products, the latency probes and the recent chat, up to date. It returns the events to send upstream: usually the event itself,
+// none when it should not be sent, or more when a tracker derives events from it.
+func (l *Live) track(e Event) []Event {
+	switch e := e.(type) {
+	case EnvelopeEvent:
+		return only(l.trackEnvelope(e))
+	case GoalUpdateEvent:
+		return []Event{l.trackGoal(e)}
+	case ChatEvent:
+		return l.trackChat(e)
+	case ChatDeleteEvent:
+		return []Event{l.trackChatDelete(e)}
+	case CoHostChangeEvent:
+		return only(l.trackCoHosts(e))
+	case RankUpdateEvent:
+		return []Event{l.trackRank(e)}
+	case ShoppingEvent:
+		return []Event{l.trackProduct(e)}
+	case PollEvent:
+		return []Event{l.trackPoll(e)}
+	case MsgDetectEvent:
+		return []Event{l.trackDetect(e)}
+	case MicBattleEvent, BattlesEvent, BattleEvent:
+		return l.trackBattle(e)
+	}
+	return []Event{e}
+}
+
+// only returns e when ok.
+func only[T Event](e T, ok bool) []Event {
+	if !ok {
+		return nil
+	}
+	return []Event{e}
+}
+
+// stopTrackers stops the timers of all trackers, it must be called before the Events channel is closed.
+func (l *Live) stopTrackers() {
+	l.envelopes.stop()
+}
+
+// syncClock keeps the difference between the server clock, as sent with every WebcastResponse, and the local clock.
+func (l *Live) syncClock(serverNow int64) {
+	if serverNow == 0 {
+		return
+	}
+	l.clockOffset.Store(serverNow - time.Now().UnixMilli())
+}
+
+// serverNow is the current time on the server clock.
+func (l *Live) serverNow() time.Time {
+	return time.Now().Add(time.Duration(l.clockOffset.Load()) * time.Millisecond)
+}
+
+// localTime converts a time on the server clock to the local clock.
+func (l *Live) localTime(server time.Time) time.Time {
+	return server.Add(-time.Duration(l.clockOffset.Load()) * time.Millisecond)
+}
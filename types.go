@@ -1,6 +1,8 @@
 package gotiktoklive
 
-import "time"
+import (
+	"time"
+)
 
 type Event interface {
 	CreatedTimestamp() int64
@@ -12,6 +14,8 @@ type RoomEvent struct {
 	MessageID int64
 	Type      string
 	Message   string
+	// Text is the display text Message was rendered from, when TikTok sent one.
+	Text      DisplayText
 	isHistory bool
 }
 
@@ -29,7 +33,9 @@ type ChatEvent struct {
 	Comment      string
 	User         *User
 	UserIdentity *UserIdentity
-	isHistory    bool
+	// Emotes are the emotes placed in the comment, Emote.Index is the position in the comment.
+	Emotes    []*Emote
+	isHistory bool
 }
 
 func (c ChatEvent) IsHistory() bool {
@@ -53,6 +59,7 @@ type UserEvent struct {
 	MessageID int64
 	Event     userEventType
 	User      *User
+	Text      DisplayText
 	isHistory bool
 }
 
@@ -96,6 +103,7 @@ type GiftEvent struct {
 	ToUserID     int64
 	User         *User
 	UserIdentity *UserIdentity
+	Text         DisplayText
 	isHistory    bool
 	GroupID      int64
 	IsComboGift  bool
@@ -117,6 +125,7 @@ type LikeEvent struct {
 	User        *User
 	DisplayType string
 	Label       string
+	Text        DisplayText
 	isHistory   bool
 }
 
@@ -168,6 +177,9 @@ type MicBattleEvent struct {
 	MessageID int64
 	Timestamp int64
 	Users     []*User
+	// BattleID identifies the battle, see BattleEvent for its full state.
+	BattleID  int64
+	battle    *battleUpdate
 	isHistory bool
 }
 
@@ -184,6 +196,9 @@ type BattlesEvent struct {
 	Timestamp int64
 	Status    int
 	Battles   []*Battle
+	// BattleID identifies the battle, see BattleEvent for its full state.
+	BattleID  int64
+	battle    *battleUpdate
 	isHistory bool
 }
 
@@ -231,6 +246,215 @@ func (i IntroEvent) CreatedTimestamp() int64 {
 	return i.Timestamp
 }
 
+// SubscribeAction tells if a SubscribeEvent is a first subscription or a renewal, from the subscription status of the
+// user before subscribing. There is no upgrade action: the OldSubscribeStatus enum TikTok sends only has first
+// subscriptions and renewals, and no other field of WebcastSubNotifyMessage tells an upgrade apart.
+type SubscribeAction string
+
+const (
+	SUBSCRIBE_UNKNOWN             SubscribeAction = "unknown"
+	SUBSCRIBE_FIRST               SubscribeAction = "user subscribed for the first time"
+	SUBSCRIBE_RENEWAL             SubscribeAction = "user renewed the subscription"
+	SUBSCRIBE_RENEWAL_IN_GRACE    SubscribeAction = "user renewed the subscription in its grace period"
+	SUBSCRIBE_RENEWAL_AFTER_GRACE SubscribeAction = "user renewed the subscription after its grace period"
+)
+
+// SubscribeType is how a subscription is paid.
+type SubscribeType string
+
+const (
+	SUBSCRIBE_TYPE_UNKNOWN SubscribeType = "unknown"
+	SUBSCRIBE_TYPE_ONCE    SubscribeType = "one time"
+	SUBSCRIBE_TYPE_AUTO    SubscribeType = "auto renewing"
+)
+
+// SubscribeStatus is the state of the user's subscription after the SubscribeEvent.
+type SubscribeStatus string
+
+const (
+	SUBSCRIBE_STATUS_UNKNOWN      SubscribeStatus = "unknown"
+	SUBSCRIBE_STATUS_ONCE         SubscribeStatus = "subscribed once"
+	SUBSCRIBE_STATUS_AUTO_RENEW   SubscribeStatus = "auto renewing"
+	SUBSCRIBE_STATUS_CANCELLED    SubscribeStatus = "auto renewal cancelled"
+	SUBSCRIBE_STATUS_REFUNDED     SubscribeStatus = "refunded"
+	SUBSCRIBE_STATUS_IN_GRACE     SubscribeStatus = "in grace period"
+	SUBSCRIBE_STATUS_NOT_IN_GRACE SubscribeStatus = "not in grace period"
+)
+
+// SubscribeEvent is sent when a user subscribes to the host. Months is the number of months the user has been
+// subscribed and IsCustom is set for custom subscriptions.
+type SubscribeEvent struct {
+	MessageID int64
+	Timestamp int64
+	User      *User
+	Months    int
+	Action    SubscribeAction
+	Type      SubscribeType
+	Status    SubscribeStatus
+	IsCustom  bool
+	Text      DisplayText
+	isHistory bool
+}
+
+func (s SubscribeEvent) IsHistory() bool {
+	return s.isHistory
+}
+
+func (s SubscribeEvent) CreatedTimestamp() int64 {
+	return s.Timestamp
+}
+
+// EmoteType tells if an emote is a plain emote or a sticker.
+type EmoteType string
+
+const (
+	EMOTE_NORMAL  EmoteType = "emote"
+	EMOTE_STICKER EmoteType = "emote with sticker"
+)
+
+// EmotePrivateType tells if an emote is available to everyone or only to subscribers of the host.
+type EmotePrivateType string
+
+const (
+	EMOTE_PUBLIC     EmotePrivateType = "public emote"
+	EMOTE_SUBSCRIBER EmotePrivateType = "subscriber emote"
+)
+
+// Emote is an emote or sticker sent in chat.
+type Emote struct {
+	ID string
+	// Index is the position of the emote in the comment of a ChatEvent, it is always 0 in an EmoteChatEvent.
+	Index       int
+	Image       []string
+	Animated    bool
+	Type        EmoteType
+	PrivateType EmotePrivateType
+}
+
+// EmoteChatEvent is sent when a user sends emotes or stickers on their own, without a comment.
+type EmoteChatEvent struct {
+	MessageID    int64
+	Timestamp    int64
+	User         *User
+	UserIdentity *UserIdentity
+	Emotes       []*Emote
+	isHistory    bool
+}
+
+func (e EmoteChatEvent) IsHistory() bool {
+	return e.isHistory
+}
+
+func (e EmoteChatEvent) CreatedTimestamp() int64 {
+	return e.Timestamp
+}
+
+// BarrageType is the kind of a full screen banner.
+type BarrageType string
+
+const (
+	BARRAGE_UNKNOWN             BarrageType = "unknown"
+	BARRAGE_ECOM_ORDERING       BarrageType = "shop ordering"
+	BARRAGE_ECOM_BUYING         BarrageType = "shop buying"
+	BARRAGE_NORMAL              BarrageType = "normal"
+	BARRAGE_SUBSCRIBE           BarrageType = "subscribe"
+	BARRAGE_EVENT_VIEW          BarrageType = "event view"
+	BARRAGE_EVENT_REGISTERED    BarrageType = "event registered"
+	BARRAGE_SUBSCRIBE_GIFT      BarrageType = "subscription gift"
+	BARRAGE_USER_UPGRADE        BarrageType = "user level up"
+	BARRAGE_USER_GRADE_ENTRANCE BarrageType = "user level entrance"
+	BARRAGE_FANS_LEVEL_UPGRADE  BarrageType = "fan club level up"
+	BARRAGE_FANS_LEVEL_ENTRANCE BarrageType = "fan club entrance"
+	BARRAGE_GAME_PARTNERSHIP    BarrageType = "game partnership"
+)
+
+// BarrageEvent is a full screen banner, such as a user level up, a fan club member or VIP entering the room. Content
+// is the banner text with its user and gift pieces resolved.
+type BarrageEvent struct {
+	MessageID  int64
+	Timestamp  int64
+	Type       BarrageType
+	EventName  string
+	Content    DisplayText
+	Icon       []string
+	RightIcon  []string
+	Background []string
+	Duration   time.Duration
+	// User and Grade are set for the level up and entrance banners, Grade is the user or fan club level.
+	User  *User
+	Grade int
+	// GiftSubCount is the number of gifted subscriptions of a BARRAGE_SUBSCRIBE_GIFT banner.
+	GiftSubCount int64
+	isHistory    bool
+}
+
+func (b BarrageEvent) IsHistory() bool {
+	return b.isHistory
+}
+
+func (b BarrageEvent) CreatedTimestamp() int64 {
+	return b.Timestamp
+}
+
+// SystemEvent is a notice from TikTok shown in the chat, such as a reminder of the community guidelines.
+type SystemEvent struct {
+	MessageID int64
+	Timestamp int64
+	Message   string
+	// Text is the display text of the notice when TikTok sent one.
+	Text      DisplayText
+	isHistory bool
+}
+
+func (s SystemEvent) IsHistory() bool {
+	return s.isHistory
+}
+
+func (s SystemEvent) CreatedTimestamp() int64 {
+	return s.Timestamp
+}
+
+// UnauthorizedMemberEvent is sent when a viewer that is not logged in or hides their identity joins the room. The
+// viewer is only known by the nickname TikTok shows.
+type UnauthorizedMemberEvent struct {
+	MessageID int64
+	Timestamp int64
+	Action    int
+	Nickname  string
+	// NicknamePrefix is shown before the nickname, EnterText is the text shown for the join.
+	NicknamePrefix DisplayText
+	EnterText      DisplayText
+	isHistory      bool
+}
+
+func (u UnauthorizedMemberEvent) IsHistory() bool {
+	return u.isHistory
+}
+
+func (u UnauthorizedMemberEvent) CreatedTimestamp() int64 {
+	return u.Timestamp
+}
+
+// RoomVerifyEvent is sent when the room requires a verification, such as an age gate, before it can be watched.
+// CloseRoom is set when the room is closed until the verification is done.
+type RoomVerifyEvent struct {
+	MessageID  int64
+	Timestamp  int64
+	Action     int
+	Content    string
+	NoticeType int64
+	CloseRoom  bool
+	isHistory  bool
+}
+
+func (r RoomVerifyEvent) IsHistory() bool {
+	return r.isHistory
+}
+
+func (r RoomVerifyEvent) CreatedTimestamp() int64 {
+	return r.Timestamp
+}
+
 type Battle struct {
 	Host   int64
 	Groups []*BattleGroup
@@ -1085,8 +1309,8 @@ type SignedURL struct {
 }
 
 // DisconnectEvent sent went disconnected from live. When this event occurs no other events will be emitted and the live
-// instance should be closed with `Closed`. A new track user/room should be invoked to reconnect if desired. This event
-// should always be emitted.
+// instance should be closed with `Closed`. This is only sent once reconnecting is disabled or has given up, a new track
+// user/room should be invoked to reconnect if desired. This event should always be emitted.
 type DisconnectEvent struct {
 	created time.Time
 }
@@ -1099,6 +1323,37 @@ func (d DisconnectEvent) CreatedTimestamp() int64 {
 	return d.created.Unix()
 }
 
+// ReconnectingEvent is sent when the websocket was lost and the live is about to wait Delay before reconnect attempt
+// Attempt. Err is the reason the previous connection or attempt failed.
+type ReconnectingEvent struct {
+	Attempt int
+	Delay   time.Duration
+	Err     error
+	created time.Time
+}
+
+func (r ReconnectingEvent) IsHistory() bool {
+	return false
+}
+
+func (r ReconnectingEvent) CreatedTimestamp() int64 {
+	return r.created.UnixMilli()
+}
+
+// ReconnectedEvent is sent when the live successfully reconnected, events continue on the same Events channel.
+type ReconnectedEvent struct {
+	Attempts int
+	created  time.Time
+}
+
+func (r ReconnectedEvent) IsHistory() bool {
+	return false
+}
+
+func (r ReconnectedEvent) CreatedTimestamp() int64 {
+	return r.created.UnixMilli()
+}
+
 type LimitInfo struct {
 	Max       int       `json:"max"`
 	Remaining int       `json:"remaining"`
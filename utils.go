@@ -8,21 +8,12 @@ import (
 	"math/rand"
 	"time"
 
-	"github.com/erni27/imcache"
 	pb "github.com/steampoweredtaco/gotiktoklive/proto"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
-const (
-	messageHistoryTimeout = 15 * time.Minute
-)
-
-var (
-	msgIDCache imcache.Cache[int64, struct{}]
-)
-
 func getRandomDeviceID() string {
 	const chars = "0123456789"
 	b := make([]byte, 20)
@@ -54,7 +45,8 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			Timestamp: pt.Common.CreateTime,
 			Type:      pt.Common.Method,
 			Message:   pt.Content,
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Text:      toDisplayText(pt.Common.DisplayText),
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastRoomPinMessage:
 		{
@@ -67,7 +59,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 					Timestamp: pt.Common.CreateTime,
 					Type:      pt.OriginalMsgType,
 					Message:   "<unknown>",
-					isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+					isHistory: msg.IsHistory,
 				}, nil
 			}
 			m := tReflect.New().Interface()
@@ -81,7 +73,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 					Timestamp: pt.Common.CreateTime,
 					Type:      pt.OriginalMsgType,
 					Message:   "<unknown>",
-					isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+					isHistory: msg.IsHistory,
 				}, nil
 			}
 
@@ -95,7 +87,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 					Timestamp: pt.Common.CreateTime,
 					Comment:   "<pinned>: " + pt2.Content,
 					User:      toUser(pt2.User),
-					isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+					isHistory: msg.IsHistory,
 				}, nil
 			default:
 				base := base64.RawStdEncoding.EncodeToString(pt.PinnedMessage)
@@ -109,7 +101,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 				Timestamp: pt.Common.CreateTime,
 				Type:      typeStr,
 				Message:   msgPinned,
-				isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+				isHistory: msg.IsHistory,
 			}, nil
 		}
 	case *pb.WebcastChatMessage:
@@ -118,8 +110,22 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			Comment:      pt.Content,
 			User:         toUser(pt.User),
 			UserIdentity: toUserIdentity(pt.UserIdentity),
+			Emotes:       toIndexedEmotes(pt.EmotesList),
+			Timestamp:    pt.Common.CreateTime,
+			isHistory:    msg.IsHistory,
+		}, nil
+	case *pb.WebcastEmoteChatMessage:
+		var emotes []*Emote
+		for _, e := range pt.EmoteList {
+			emotes = append(emotes, toEmote(e, 0))
+		}
+		return EmoteChatEvent{
+			MessageID:    pt.Common.MsgId,
 			Timestamp:    pt.Common.CreateTime,
-			isHistory:    msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			User:         toUser(pt.User),
+			UserIdentity: toUserIdentity(pt.UserIdentity),
+			Emotes:       emotes,
+			isHistory:    msg.IsHistory,
 		}, nil
 	case *pb.WebcastMemberMessage:
 		return UserEvent{
@@ -127,31 +133,39 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			Timestamp: pt.Common.CreateTime,
 			Event:     toUserType(pt.Action.String()),
 			User:      toUser(pt.User),
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Text:      toDisplayText(pt.Common.DisplayText),
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastLiveGameIntroMessage:
+		text := toDisplayText(pt.GameText)
 		return RoomEvent{
 			MessageID: pt.Common.MsgId,
 			Timestamp: pt.Common.CreateTime,
 			Type:      pt.Common.Method,
-			Message:   pt.GameText.DefaultPattern,
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Message:   text.Text,
+			Text:      text,
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastRoomMessage:
+		text := toDisplayText(pt.Common.DisplayText)
+		message := text.Text
+		if message == "" {
+			message = pt.Content
+		}
 		return RoomEvent{
 			MessageID: pt.Common.MsgId,
 			Timestamp: pt.Common.CreateTime,
 			Type:      pt.Common.Method,
-			// TODO: Make this actually use pieces list and fill out the format text correctly.
-			Message:   pt.Common.DisplayText.DefaultPattern,
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Message:   message,
+			Text:      text,
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastRoomUserSeqMessage:
 		return ViewersEvent{
 			MessageID: pt.Common.MsgId,
 			Timestamp: pt.Common.CreateTime,
 			Viewers:   int(pt.Total),
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastSocialMessage:
 		return UserEvent{
@@ -159,7 +173,8 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			Timestamp: pt.Common.CreateTime,
 			Event:     toUserType(pt.Common.DisplayText.Key),
 			User:      toUser(pt.User),
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Text:      toDisplayText(pt.Common.DisplayText),
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastGiftMessage:
 		if pt.GiftId == 0 && pt.User == nil {
@@ -180,10 +195,12 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			ToUserID:     int64(pt.UserGiftReciever.UserId),
 			User:         toUser(pt.User),
 			UserIdentity: toUserIdentity(pt.UserIdentity),
-			isHistory:    msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Text:         toDisplayText(pt.Common.DisplayText),
+			isHistory:    msg.IsHistory,
 			IsComboGift:  pt.GroupId != 0,
 		}, nil
 	case *pb.WebcastLikeMessage:
+		text := toDisplayText(pt.Common.DisplayText)
 		return LikeEvent{
 			MessageID:   pt.Common.MsgId,
 			Timestamp:   pt.Common.CreateTime,
@@ -191,17 +208,96 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			TotalLikes:  int(pt.Total),
 			User:        toUser(pt.User),
 			DisplayType: pt.Common.Method,
-			Label:       pt.Common.DisplayText.String(),
-			isHistory:   msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			Label:       text.Text,
+			Text:        text,
+			isHistory:   msg.IsHistory,
 		}, nil
 
+	case *pb.WebcastSubNotifyMessage:
+		return SubscribeEvent{
+			MessageID: pt.Common.MsgId,
+			Timestamp: pt.Common.CreateTime,
+			User:      toUser(pt.User),
+			Months:    int(pt.SubMonth),
+			Action:    toSubscribeAction(pt),
+			Type:      toSubscribeType(pt.SubscribeType),
+			Status:    toSubscribeStatus(pt.SubscribingStatus),
+			IsCustom:  pt.IsCustom,
+			Text:      toDisplayText(pt.Common.DisplayText),
+			isHistory: msg.IsHistory,
+		}, nil
+
+	case *pb.WebcastEnvelopeMessage:
+		return toEnvelopeEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastGoalUpdateMessage:
+		return toGoalUpdateEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastRankUpdateMessage:
+		return toRankUpdateEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastRankTextMessage:
+		return toRankTextEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastHourlyRankMessage:
+		return toHourlyRankEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastBarrageMessage:
+		return toBarrageEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastCaptionMessage:
+		return toCaptionEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastImDeleteMessage:
+		return toChatDeleteEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastOecLiveShoppingMessage:
+		return toShoppingEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastPollMessage:
+		return toPollEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastSystemMessage:
+		return SystemEvent{
+			MessageID: pt.Common.MsgId,
+			Timestamp: pt.Common.CreateTime,
+			Message:   pt.Message,
+			Text:      toDisplayText(pt.Common.DisplayText),
+			isHistory: msg.IsHistory,
+		}, nil
+
+	case *pb.WebcastUnauthorizedMemberMessage:
+		return UnauthorizedMemberEvent{
+			MessageID:      pt.Common.MsgId,
+			Timestamp:      pt.Common.CreateTime,
+			Action:         int(pt.Action),
+			Nickname:       pt.NickName,
+			NicknamePrefix: toDisplayText(pt.NickNamePrefix),
+			EnterText:      toDisplayText(pt.EnterText),
+			isHistory:      msg.IsHistory,
+		}, nil
+
+	case *pb.RoomVerifyMessage:
+		return RoomVerifyEvent{
+			MessageID:  pt.Common.MsgId,
+			Timestamp:  pt.Common.CreateTime,
+			Action:     int(pt.Action),
+			Content:    pt.Content,
+			NoticeType: pt.NoticeType,
+			CloseRoom:  pt.CloseRoom,
+			isHistory:  msg.IsHistory,
+		}, nil
+
+	case *pb.WebcastMsgDetectMessage:
+		return toMsgDetectEvent(pt, msg.IsHistory), nil
+
 	case *pb.WebcastQuestionNewMessage:
 		return QuestionEvent{
 			MessageID: pt.Common.MsgId,
 			Timestamp: pt.Common.CreateTime,
 			Quesion:   pt.Details.Text,
 			User:      toUser(pt.Details.User),
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			isHistory: msg.IsHistory,
 		}, nil
 
 	case *pb.WebcastControlMessage:
@@ -210,7 +306,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			Timestamp:   pt.Common.CreateTime,
 			Action:      int(pt.Action),
 			Description: pt.Action.String(),
-			isHistory:   msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			isHistory:   msg.IsHistory,
 		}, nil
 
 	case *pb.WebcastLinkMicBattle:
@@ -240,9 +336,23 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			MessageID: pt.Common.MsgId,
 			Timestamp: pt.Common.CreateTime,
 			Users:     users,
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			BattleID:  int64(pt.Id),
+			battle:    toMicBattleUpdate(pt),
+			isHistory: msg.IsHistory,
 		}, nil
 
+	case *pb.WebcastLinkMessage:
+		return toLinkEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastLinkLayerMessage:
+		return toLinkLayerEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastLinkmicBattleTaskMessage:
+		return toBattleTaskEvent(pt, msg.IsHistory), nil
+
+	case *pb.WebcastLinkMicBattlePunishFinish:
+		return toBattlePunishFinishEvent(pt, msg.IsHistory), nil
+
 	case *pb.WebcastLinkMicArmies:
 		battles := []*Battle{}
 		for _, b := range pt.BattleItems {
@@ -267,7 +377,9 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			Timestamp: pt.Common.CreateTime,
 			Status:    int(pt.BattleStatus),
 			Battles:   battles,
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			BattleID:  int64(pt.Id),
+			battle:    toArmiesUpdate(pt),
+			isHistory: msg.IsHistory,
 		}, nil
 	case *pb.WebcastLiveIntroMessage:
 		return IntroEvent{
@@ -276,7 +388,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			ID:        int(pt.RoomId),
 			Title:     pt.Content,
 			User:      toUser(pt.Host),
-			isHistory: msg.IsHistory || cachedHistory(pt.Common.MsgId),
+			isHistory: msg.IsHistory,
 		}, nil
 
 	case *pb.WebcastInRoomBannerMessage:
@@ -291,7 +403,7 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 			MessageID: pt.Header.MsgId,
 			Timestamp: pt.Header.CreateTime,
 			Data:      data,
-			isHistory: msg.IsHistory || cachedHistory(pt.Header.MsgId),
+			isHistory: msg.IsHistory,
 		}, nil
 
 	default:
@@ -303,11 +415,6 @@ func parseMsg(msg *pb.WebcastResponse_Message, warnHandler func(...interface{}),
 	}
 }
 
-func cachedHistory(id int64) bool {
-	_, present := msgIDCache.GetOrSet(id, struct{}{}, imcache.WithExpiration(messageHistoryTimeout))
-	return present
-}
-
 func defaultLogHandler(i ...interface{}) {
 	slog.Debug(fmt.Sprint(i...), "logger", "gotiktoklive-default")
 }
@@ -390,3 +497,149 @@ func toUserType(displayType string) userEventType {
 	}
 	return userEventType(fmt.Sprintf("User type not implemented, please report: %s", displayType))
 }
+
+// toSubscribeAction maps the subscription status of the user before subscribing.
+func toSubscribeAction(m *pb.WebcastSubNotifyMessage) SubscribeAction {
+	switch m.OldSubscribeStatus {
+	case pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_FIRST:
+		return SUBSCRIBE_FIRST
+	case pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_RESUB:
+		return SUBSCRIBE_RENEWAL
+	case pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_SUBINGRACEPERIOD:
+		return SUBSCRIBE_RENEWAL_IN_GRACE
+	case pb.OldSubscribeStatus_OLDSUBSCRIBESTATUS_SUBNOTINGRACEPERIOD:
+		return SUBSCRIBE_RENEWAL_AFTER_GRACE
+	}
+	return SUBSCRIBE_UNKNOWN
+}
+
+func toSubscribeType(t pb.SubscribeType) SubscribeType {
+	switch t {
+	case pb.SubscribeType_SUBSCRIBETYPE_ONCE:
+		return SUBSCRIBE_TYPE_ONCE
+	case pb.SubscribeType_SUBSCRIBETYPE_AUTO:
+		return SUBSCRIBE_TYPE_AUTO
+	}
+	return SUBSCRIBE_TYPE_UNKNOWN
+}
+
+func toBarrageEvent(pt *pb.WebcastBarrageMessage, isHistory bool) BarrageEvent {
+	e := BarrageEvent{
+		MessageID:  pt.Common.MsgId,
+		Timestamp:  pt.Common.CreateTime,
+		Type:       toBarrageType(pt.MsgType),
+		EventName:  pt.GetEvent().GetEventName(),
+		Content:    toDisplayText(pt.Content),
+		Icon:       pt.GetIcon().GetUrlList(),
+		RightIcon:  pt.GetRightIcon().GetUrlList(),
+		Background: pt.GetBackground().GetUrlList(),
+		// TikTok sends the duration in milliseconds.
+		Duration:     time.Duration(pt.Duration) * time.Millisecond,
+		GiftSubCount: pt.GetSubscribeGiftParam().GetGiftSubCount(),
+		isHistory:    isHistory,
+	}
+	if p := pt.UserGradeParam; p != nil {
+		e.Grade = int(p.CurrentGrade)
+		if p.User != nil {
+			e.User = toUser(p.User)
+		}
+	}
+	if p := pt.FansLevelParam; p != nil {
+		e.Grade = int(p.CurrentGrade)
+		if p.User != nil {
+			e.User = toUser(p.User)
+		}
+	}
+	return e
+}
+
+func toBarrageType(t pb.WebcastBarrageMessage_BarrageType) BarrageType {
+	switch t {
+	case pb.WebcastBarrageMessage_ECOMORDERING:
+		return BARRAGE_ECOM_ORDERING
+	case pb.WebcastBarrageMessage_ECOMBUYING:
+		return BARRAGE_ECOM_BUYING
+	case pb.WebcastBarrageMessage_NORMAL:
+		return BARRAGE_NORMAL
+	case pb.WebcastBarrageMessage_SUBSCRIBE:
+		return BARRAGE_SUBSCRIBE
+	case pb.WebcastBarrageMessage_EVENTVIEW:
+		return BARRAGE_EVENT_VIEW
+	case pb.WebcastBarrageMessage_EVENTREGISTERED:
+		return BARRAGE_EVENT_REGISTERED
+	case pb.WebcastBarrageMessage_SUBSCRIBEGIFT:
+		return BARRAGE_SUBSCRIBE_GIFT
+	case pb.WebcastBarrageMessage_USERUPGRADE:
+		return BARRAGE_USER_UPGRADE
+	case pb.WebcastBarrageMessage_GRADEUSERENTRANCENOTIFICATION:
+		return BARRAGE_USER_GRADE_ENTRANCE
+	case pb.WebcastBarrageMessage_FANSLEVELUPGRADE:
+		return BARRAGE_FANS_LEVEL_UPGRADE
+	case pb.WebcastBarrageMessage_FANSLEVELENTRANCE:
+		return BARRAGE_FANS_LEVEL_ENTRANCE
+	case pb.WebcastBarrageMessage_GAMEPARTNERSHIP:
+		return BARRAGE_GAME_PARTNERSHIP
+	}
+	return BARRAGE_UNKNOWN
+}
+
+func toIndexedEmotes(list []*pb.WebcastChatMessage_EmoteWithIndex) []*Emote {
+	var emotes []*Emote
+	for _, e := range list {
+		if e.Emote != nil {
+			emotes = append(emotes, toEmote(e.Emote, int(e.Index)))
+		}
+	}
+	return emotes
+}
+
+func toEmote(e *pb.Emote, index int) *Emote {
+	emote := &Emote{
+		ID:          e.EmoteId,
+		Index:       index,
+		Type:        EMOTE_NORMAL,
+		PrivateType: EMOTE_PUBLIC,
+	}
+	if e.Image != nil {
+		emote.Image = e.Image.UrlList
+		emote.Animated = e.Image.IsAnimated
+	}
+	if e.EmoteType == pb.EmoteType_EMOTETYPEWITHSTICKER {
+		emote.Type = EMOTE_STICKER
+	}
+	if e.EmotePrivateType == pb.EmotePrivateType_EMOTE_PRIVATE_TYPE_SUB_WAVE {
+		emote.PrivateType = EMOTE_SUBSCRIBER
+	}
+	return emote
+}
+
+func toSubscribeStatus(s pb.SubscribingStatus) SubscribeStatus {
+	switch s {
+	case pb.SubscribingStatus_SUBSCRIBINGSTATUS_ONCE:
+		return SUBSCRIBE_STATUS_ONCE
+	case pb.SubscribingStatus_SUBSCRIBINGSTATUS_CIRCLE:
+		return SUBSCRIBE_STATUS_AUTO_RENEW
+	case pb.SubscribingStatus_SUBSCRIBINGSTATUS_CIRCLECANCEL:
+		return SUBSCRIBE_STATUS_CANCELLED
+	case pb.SubscribingStatus_SUBSCRIBINGSTATUS_REFUND:
+		return SUBSCRIBE_STATUS_REFUNDED
+	case pb.SubscribingStatus_SUBSCRIBINGSTATUS_INGRACEPERIOD:
+		return SUBSCRIBE_STATUS_IN_GRACE
+	case pb.SubscribingStatus_SUBSCRIBINGSTATUS_NOTINGRACEPERIOD:
+		return SUBSCRIBE_STATUS_NOT_IN_GRACE
+	}
+	return SUBSCRIBE_STATUS_UNKNOWN
+}
+
+// unixTime converts a timestamp TikTok sends in either seconds or milliseconds, falling back to the message creation
+// time in milliseconds when it is 0.
+func unixTime(ts uint64, created int64) time.Time {
+	switch {
+	case ts == 0:
+		return time.UnixMilli(created)
+	case ts < 1e11:
+		return time.Unix(int64(ts), 0)
+	default:
+		return time.UnixMilli(int64(ts))
+	}
+}
@@ -0,0 +1,496 @@
+// Package webcasttest provides a fake TikTok Webcast backend for hermetic tests.
+//
+// The server serves the endpoints the library needs to track a live: the user live page with a SIGI_STATE blob,
+// room/info/, room/check_alive/, the signer's /webcast/fetch/ and /webcast/rate_limits and a WebSocket push endpoint
+// that emits scripted WebcastPushFrame messages. Point a TikTok instance at it with options:
+//
+//	srv := webcasttest.NewServer()
+//	defer srv.Close()
+//	tiktok, err := gotiktoklive.NewTikTok(
+//		gotiktoklive.TikTokUrl(srv.TikTokURL()),
+//		gotiktoklive.WebcastUrl(srv.WebcastURL()),
+//		gotiktoklive.SigningUrl(srv.SignerURL()),
+//	)
+package webcasttest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gobwas/ws"
+	"github.com/gobwas/ws/wsutil"
+	pb "github.com/steampoweredtaco/gotiktoklive/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultUsername = "webcasttest"
+	DefaultRoomID   = "7000000000000000001"
+	DefaultUserID   = "6000000000000000001"
+
+	pushPath = "/webcast/im/push/"
+	// TTCookie is returned in X-Set-TT-Cookie with every room data fetch.
+	TTCookie = "ttwid=webcasttest"
+)
+
+// ErrNoConnections is returned when pushing while no client is connected to the push endpoint.
+var ErrNoConnections = errors.New("no websocket connections")
+
+// Option configures a Server.
+type Option func(s *Server)
+
+// WithUser sets the username and room the fake backend serves.
+func WithUser(username, roomID string) Option {
+	return func(s *Server) {
+		s.username = username
+		s.roomID = roomID
+	}
+}
+
+// WithRoomMessages sets the messages returned by the initial room data fetch.
+func WithRoomMessages(msgs ...*pb.WebcastResponse_Message) Option {
+	return func(s *Server) {
+		s.roomMessages = msgs
+	}
+}
+
+// WithPushOnConnect sets responses that are pushed to every new websocket connection right after it is accepted.
+func WithPushOnConnect(resps ...*pb.WebcastResponse) Option {
+	return func(s *Server) {
+		s.onConnect = resps
+	}
+}
+
+// WithSignerStatus makes the signer answer every fetch with the given status code, e.g. to test signer failover.
+func WithSignerStatus(code int) Option {
+	return func(s *Server) {
+		s.signerStatus = code
+	}
+}
+
+// WithSignerLimits sets the limits the signer advertises on /webcast/rate_limits, the defaults are 10000 a day, 1000
+// an hour and 600 a minute.
+func WithSignerLimits(day, hour, minute int) Option {
+	return func(s *Server) {
+		s.limits = [3]int{day, hour, minute}
+	}
+}
+
+// WithEnded makes the room look like the stream has ended.
+func WithEnded() Option {
+	return func(s *Server) {
+		s.ended = true
+	}
+}
+
+// Server is a fake Webcast backend and signer.
+type Server struct {
+	*httptest.Server
+
+	username     string
+	roomID       string
+	ended        bool
+	signerStatus int
+	limits       [3]int
+	roomMessages []*pb.WebcastResponse_Message
+	onConnect    []*pb.WebcastResponse
+
+	mu          sync.Mutex
+	conns       map[*wsConn]struct{}
+	connected   chan struct{}
+	totalConns  int
+	logID       uint64
+	acks        []uint64
+	pings       int
+	fetchCursor []string
+}
+
+type wsConn struct {
+	mu    sync.Mutex
+	conn  net.Conn
+	write func([]byte) error
+}
+
+// NewServer starts a fake Webcast backend, it must be closed with Close.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		username:  DefaultUsername,
+		roomID:    DefaultRoomID,
+		limits:    [3]int{10000, 1000, 600},
+		conns:     map[*wsConn]struct{}{},
+		connected: make(chan struct{}, 100),
+	}
+	for _, option := range options {
+		option(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handleRoot)
+	mux.HandleFunc("/webcast/room/info/", s.handleRoomInfo)
+	mux.HandleFunc("/webcast/room/check_alive/", s.handleCheckAlive)
+	mux.HandleFunc("/webcast/fetch/", s.handleFetch)
+	mux.HandleFunc("/webcast/rate_limits", s.handleRateLimits)
+	mux.HandleFunc(pushPath, s.handlePush)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// TikTokURL is the value to use with the TikTokUrl option.
+func (s *Server) TikTokURL() string {
+	return s.URL + "/"
+}
+
+// WebcastURL is the value to use with the WebcastUrl option.
+func (s *Server) WebcastURL() string {
+	return s.URL + "/webcast/"
+}
+
+// SignerURL is the value to use with the SigningUrl option.
+func (s *Server) SignerURL() string {
+	return s.URL
+}
+
+// PushURL is the websocket push server url, as returned in WebcastResponse.PushServer.
+func (s *Server) PushURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + pushPath
+}
+
+// Username returns the username the fake serves a live room for.
+func (s *Server) Username() string {
+	return s.username
+}
+
+// RoomID returns the id of the fake live room.
+func (s *Server) RoomID() string {
+	return s.roomID
+}
+
+// Close closes all websocket connections and shuts down the server.
+func (s *Server) Close() {
+	s.DropConnections()
+	s.Server.Close()
+}
+
+func (s *Server) handleRoot(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	user := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/@"), "/live/")
+	if !strings.HasPrefix(r.URL.Path, "/@") || user != s.username {
+		http.NotFound(w, r)
+		return
+	}
+
+	roomID := s.roomID
+	if s.ended {
+		roomID = ""
+	}
+	state := map[string]any{
+		"liveRoom": map[string]any{
+			"liveRoomUserInfo": map[string]any{
+				"user": map[string]any{
+					"id":       DefaultUserID,
+					"uniqueId": s.username,
+					"nickname": s.username,
+					"roomId":   roomID,
+				},
+				"stats": map[string]any{
+					"followerCount":  1,
+					"followingCount": 1,
+				},
+			},
+		},
+	}
+	b, _ := json.Marshal(state)
+	w.Header().Set("Content-Type", "text/html")
+	fmt.Fprintf(w, `<html><body><script id="SIGI_STATE" type="application/json">%s</script></body></html>`, b)
+}
+
+func (s *Server) handleRoomInfo(w http.ResponseWriter, r *http.Request) {
+	status := 2
+	if s.ended {
+		status = 4
+	}
+	writeJSON(w, map[string]any{
+		"data": map[string]any{
+			"id_str":      s.roomID,
+			"status":      status,
+			"title":       s.username + " live",
+			"create_time": time.Now().Unix(),
+			"owner": map[string]any{
+				"id_str":     DefaultUserID,
+				"display_id": s.username,
+				"nickname":   s.username,
+			},
+		},
+		"extra":       map[string]any{"now": time.Now().UnixMilli()},
+		"status_code": 0,
+	})
+}
+
+func (s *Server) handleCheckAlive(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{
+		"data": []map[string]any{{
+			"alive":       !s.ended,
+			"room_id_str": s.roomID,
+		}},
+		"extra":       map[string]any{"now": time.Now().UnixMilli()},
+		"status_code": 0,
+	})
+}
+
+func (s *Server) handleRateLimits(w http.ResponseWriter, r *http.Request) {
+	reset := time.Now().Add(time.Minute).UTC()
+	limit := func(max int) map[string]any {
+		return map[string]any{"max": max, "remaining": max, "reset_at": reset}
+	}
+	writeJSON(w, map[string]any{
+		"code":   200,
+		"day":    limit(s.limits[0]),
+		"hour":   limit(s.limits[1]),
+		"minute": limit(s.limits[2]),
+	})
+}
+
+// handleFetch plays the signer, it answers with the room data as protobuf like the Euler signer does.
+func (s *Server) handleFetch(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.fetchCursor = append(s.fetchCursor, cursorOf(r.URL.Query().Get("url")))
+	s.mu.Unlock()
+	if s.signerStatus != 0 {
+		http.Error(w, http.StatusText(s.signerStatus), s.signerStatus)
+		return
+	}
+
+	rsp := &pb.WebcastResponse{
+		Messages:       s.roomMessages,
+		Cursor:         fmt.Sprintf("%d", time.Now().UnixNano()),
+		InternalExt:    []byte("internal_ext:webcasttest"),
+		FetchInterval:  1000,
+		Now:            time.Now().UnixMilli(),
+		PushServer:     s.PushURL(),
+		RouteParamsMap: map[string]string{"im_path": pushPath},
+	}
+	b, err := proto.Marshal(rsp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("X-Set-TT-Cookie", TTCookie)
+	w.Header().Set("Content-Type", "application/protobuf")
+	_, _ = w.Write(b)
+}
+
+func cursorOf(fetchURL string) string {
+	u, err := url.Parse(fetchURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("cursor")
+}
+
+func (s *Server) handlePush(w http.ResponseWriter, r *http.Request) {
+	conn, _, _, err := ws.UpgradeHTTP(r, w)
+	if err != nil {
+		return
+	}
+	c := &wsConn{conn: conn}
+	c.write = func(b []byte) error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return wsutil.WriteServerBinary(conn, b)
+	}
+
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.totalConns++
+	s.mu.Unlock()
+
+	for _, rsp := range s.onConnect {
+		if err := s.pushTo(c, rsp); err != nil {
+			break
+		}
+	}
+	select {
+	case s.connected <- struct{}{}:
+	default:
+	}
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.conns, c)
+			s.mu.Unlock()
+			_ = conn.Close()
+		}()
+		for {
+			b, op, err := wsutil.ReadClientData(conn)
+			if err != nil {
+				return
+			}
+			if op != ws.OpBinary {
+				continue
+			}
+			var frame pb.WebcastPushFrame
+			if err := proto.Unmarshal(b, &frame); err != nil {
+				continue
+			}
+			s.mu.Lock()
+			switch frame.PayloadType {
+			case "hb":
+				s.pings++
+			case "ack":
+				s.acks = append(s.acks, frame.LogId)
+			}
+			s.mu.Unlock()
+		}
+	}()
+}
+
+// Push sends rsp wrapped in a msg WebcastPushFrame to every connected client.
+func (s *Server) Push(rsp *pb.WebcastResponse) error {
+	s.mu.Lock()
+	conns := make([]*wsConn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+	if len(conns) == 0 {
+		return ErrNoConnections
+	}
+	var errs []error
+	for _, c := range conns {
+		errs = append(errs, s.pushTo(c, rsp))
+	}
+	return errors.Join(errs...)
+}
+
+// PushMessages pushes msgs in a single WebcastResponse that requires an ack.
+func (s *Server) PushMessages(msgs ...*pb.WebcastResponse_Message) error {
+	return s.Push(&pb.WebcastResponse{
+		Messages: msgs,
+		Cursor:   fmt.Sprintf("%d", time.Now().UnixNano()),
+		NeedsAck: true,
+	})
+}
+
+// PushFrame sends a raw frame to every connected client as is.
+func (s *Server) PushFrame(frame *pb.WebcastPushFrame) error {
+	b, err := proto.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	conns := make([]*wsConn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+	if len(conns) == 0 {
+		return ErrNoConnections
+	}
+	var errs []error
+	for _, c := range conns {
+		errs = append(errs, c.write(b))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Server) pushTo(c *wsConn, rsp *pb.WebcastResponse) error {
+	payload, err := proto.Marshal(rsp)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.logID++
+	logID := s.logID
+	s.mu.Unlock()
+	b, err := proto.Marshal(&pb.WebcastPushFrame{
+		LogId:       logID,
+		PayloadType: "msg",
+		Payload:     payload,
+	})
+	if err != nil {
+		return err
+	}
+	return c.write(b)
+}
+
+var msgID atomic.Int64
+
+// NewMessage wraps m in a WebcastResponse_Message with the method set to the message's proto name so it is parsed
+// like a message from TikTok.
+func NewMessage(m proto.Message) *pb.WebcastResponse_Message {
+	payload, err := proto.Marshal(m)
+	if err != nil {
+		panic(fmt.Sprintf("webcasttest: cannot marshal %T: %s", m, err))
+	}
+	return &pb.WebcastResponse_Message{
+		Method:  string(m.ProtoReflect().Descriptor().FullName()),
+		Payload: payload,
+		MsgId:   msgID.Add(1),
+	}
+}
+
+// WaitForConnection blocks until a client connected to the push endpoint or the timeout passed.
+func (s *Server) WaitForConnection(timeout time.Duration) error {
+	select {
+	case <-s.connected:
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("no websocket connection within %s", timeout)
+	}
+}
+
+// DropConnections closes every open websocket connection, e.g. to simulate TikTok rotating the push server.
+func (s *Server) DropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.conns {
+		_ = c.conn.Close()
+		delete(s.conns, c)
+	}
+}
+
+// Connections returns the number of websocket connections accepted so far.
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.totalConns
+}
+
+// Acks returns the log ids of all acks received.
+func (s *Server) Acks() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint64(nil), s.acks...)
+}
+
+// Pings returns the number of heartbeat frames received.
+func (s *Server) Pings() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pings
+}
+
+// FetchCursors returns the cursor sent with each room data fetch, in order. The first fetch has an empty cursor.
+func (s *Server) FetchCursors() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.fetchCursor...)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -3,6 +3,7 @@ package gotiktoklive
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -19,7 +20,7 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func (l *Live) connect(addr string, params map[string]string) error {
+func (l *Live) connect(ctx context.Context, addr string, params map[string]string) error {
 	u, err := url.Parse("https://tiktok.com/")
 	if err != nil {
 		return nil
@@ -89,35 +90,46 @@ func (l *Live) connect(addr string, params map[string]string) error {
 	dialer := ws.Dialer{
 		Header: ws.HandshakeHeaderHTTP(headers),
 		NetDial: func(ctx context.Context, a, b string) (net.Conn, error) {
+			if d, ok := proxyNetDial.(proxy.ContextDialer); ok {
+				return d.DialContext(ctx, a, b)
+			}
 			return proxyNetDial.Dial(a, b)
 		},
 		// NetDial:   proxy.Dial,
 		Protocols: []string{"echo-protocol"},
 	}
-	conn, _, _, err := dialer.Dial(context.Background(), wsURL)
+	conn, _, _, err := dialer.Dial(ctx, wsURL)
 	if err != nil {
 		return fmt.Errorf("Failed to connect to %s: %w", wsURL, err)
 	}
+	l.wssMu.Lock()
+	defer l.wssMu.Unlock()
+	select {
+	case <-l.done():
+		// Closed while dialing, the close already happened so this connection would never be released.
+		conn.Close()
+		return errors.New("live was closed while connecting")
+	default:
+	}
+	if l.wss != nil {
+		l.wss.Close()
+	}
 	l.wss = conn
 	return nil
 }
 
-func (l *Live) readSocket() {
-	defer l.wss.Close()
-	defer func() {
-		select {
-		case <-time.After(5 * time.Second):
-		case l.Events <- &DisconnectEvent{created: time.Now()}:
-		}
-	}()
-	defer l.cancel()
+// readSocket reads from the current websocket until it fails or the live is closed. A nil error means the live
+// was closed on purpose and must not be reconnected.
+func (l *Live) readSocket() error {
+	conn := l.conn()
+	defer conn.Close()
 
 	want := ws.OpBinary
 	s := ws.StateClientSide
 
-	controlHandler := wsutil.ControlFrameHandler(l.wss, s)
+	controlHandler := wsutil.ControlFrameHandler(conn, s)
 	rd := wsutil.Reader{
-		Source:          l.wss,
+		Source:          conn,
 		State:           s,
 		CheckUTF8:       true,
 		SkipHeaderCheck: false,
@@ -127,26 +139,32 @@ func (l *Live) readSocket() {
 	for {
 		hdr, err := rd.NextFrame()
 		if err != nil {
+			select {
+			case <-l.done():
+				return nil
+			case <-l.t.done():
+				return nil
+			default:
+			}
 			l.t.errHandler(fmt.Errorf("failed to read websocket from server: %w", err))
-			l.wss.Close()
-			return
+			return fmt.Errorf("failed to read websocket from server: %w", err)
 		}
 		// If msg is ping or close
 		if hdr.OpCode.IsControl() {
-			if err := controlHandler(hdr, &rd); err != nil {
+			err := controlHandler(hdr, &rd)
+			if hdr.OpCode == ws.OpClose {
+				l.t.warnHandler("Websocket connection was closed by server.")
+				if l.t.enableWSTrace {
+					l.t.wsTraceChan <- struct{ direction, hex string }{direction: "<=", hex: "websocket closed"}
+				}
+				return errors.New("websocket connection was closed by server")
+			}
+			if err != nil {
 				l.t.errHandler(fmt.Errorf("websocket control handler failed: %w", err))
 			}
 			continue
 		}
 
-		if hdr.OpCode == ws.OpClose {
-			l.t.warnHandler("Websocket connection was closed by server.")
-			if l.t.enableWSTrace {
-				l.t.wsTraceChan <- struct{ direction, hex string }{direction: "<=", hex: "websocket closed"}
-			}
-			return
-		}
-
 		// Wrong OpCode
 		if hdr.OpCode&want == 0 {
 			msgBytes, err := io.ReadAll(&rd)
@@ -168,6 +186,8 @@ func (l *Live) readSocket() {
 
 		if err != nil {
 			l.t.errHandler(fmt.Errorf("Failed to read websocket message: %w", err))
+		} else {
+			l.record(RecordPushFrame, msgBytes)
 		}
 
 		if err := l.parseWssMsg(msgBytes); err != nil {
@@ -177,27 +197,137 @@ func (l *Live) readSocket() {
 		// Gracefully shutdown
 		select {
 		case <-l.done():
-			return
+			return nil
 		case <-l.t.done():
 			l.t.infoHandler("Close websocket, global context done")
+			return nil
+		default:
+		}
+	}
+}
+
+// run keeps the live connected until it is closed, the stream ends or reconnecting gives up. The Events channel
+// stays open across reconnects and a DisconnectEvent is always the last event sent.
+func (l *Live) run() {
+	defer func() {
+		l.stopTrackers()
+		select {
+		case <-time.After(5 * time.Second):
+		case l.Events <- &DisconnectEvent{created: time.Now()}:
+		}
+	}()
+	defer l.cancel()
+
+	for {
+		stopPing := make(chan struct{})
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.sendPing(stopPing)
+		}()
+		err := l.readSocket()
+		close(stopPing)
+		if err == nil || !l.t.shouldReconnect {
 			return
+		}
+		if !l.reconnect(err) {
+			return
+		}
+	}
+}
+
+// reconnect fetches the room data again with the saved cursor and internal_ext so no messages are lost, then dials
+// the new push server. Attempts back off exponentially as configured with ReconnectBackoff.
+func (l *Live) reconnect(cause error) bool {
+	delay := l.t.reconnectDelay
+	for attempt := 1; l.t.reconnectMaxAttempts == 0 || attempt <= l.t.reconnectMaxAttempts; attempt++ {
+		l.sendEvent(ReconnectingEvent{
+			Attempt: attempt,
+			Delay:   delay,
+			Err:     cause,
+			created: time.Now(),
+		})
+		select {
+		case <-time.After(delay):
+		case <-l.done():
+			return false
+		case <-l.t.done():
+			return false
+		}
+
+		if err := l.getRoomData(l.ctx); err != nil {
+			cause = err
+			l.t.warnHandler(fmt.Errorf("reconnect attempt %d failed to fetch room data: %w", attempt, err))
+		} else if err := l.connect(l.ctx, l.wsURL, l.wsParams); err != nil {
+			cause = err
+			l.t.warnHandler(fmt.Errorf("reconnect attempt %d failed: %w", attempt, err))
+		} else {
+			l.t.infoHandler("Reconnected to websocket")
+			l.sendEvent(ReconnectedEvent{
+				Attempts: attempt,
+				created:  time.Now(),
+			})
+			return true
+		}
+
+		delay *= 2
+		if delay > l.t.reconnectMaxDelay {
+			delay = l.t.reconnectMaxDelay
+		}
+	}
+	l.t.errHandler(fmt.Errorf("giving up reconnecting: %w", cause))
+	return false
+}
+
+// sendEvent sends an event upstream, if the channel is full the oldest event is discarded.
+func (l *Live) sendEvent(e Event) {
+	if l.replay {
+		// Replays are used as regression fixtures so never drop events, wait for the reader instead.
+		select {
+		case l.Events <- e:
+		case <-l.done():
+		}
+		return
+	}
+	// The envelope timers send alongside the websocket reader, drop the oldest events until there is room so neither
+	// blocks on a full channel.
+	for {
+		select {
+		case l.Events <- e:
+			return
+		default:
+		}
+		select {
+		case <-l.Events:
+			l.overflow.Add(1)
 		default:
 		}
 	}
 }
 
+// conn returns the current websocket connection which is replaced on every reconnect.
+func (l *Live) conn() net.Conn {
+	l.wssMu.Lock()
+	defer l.wssMu.Unlock()
+	return l.wss
+}
+
+func (l *Live) closeWss() {
+	l.wssMu.Lock()
+	defer l.wssMu.Unlock()
+	if l.wss != nil {
+		l.wss.Close()
+	}
+}
+
 func (l *Live) parseWssMsg(wssMsg []byte) error {
-	var rsp pb.WebcastPushFrame
-	if err := proto.Unmarshal(wssMsg, &rsp); err != nil {
-		return fmt.Errorf("Failed to unmarshal proto WebcastWebsocketMessage: %w", err)
+	rsp, response, err := DecodeFrame(wssMsg)
+	if err != nil {
+		return err
 	}
 
-	if rsp.PayloadType == "msg" {
-		var response pb.WebcastResponse
-		if err := proto.Unmarshal(rsp.Payload, &response); err != nil {
-			return fmt.Errorf("Failed to unmarshal proto WebcastResponse: %w", err)
-		}
-		if response.NeedsAck {
+	if response != nil {
+		if response.NeedsAck && !l.replay {
 			if err := l.sendAck(rsp.LogId, response.InternalExt); err != nil {
 				// Might as well finishing processing all messages, the connection reset will be
 				// caught later
@@ -205,23 +335,29 @@ func (l *Live) parseWssMsg(wssMsg []byte) error {
 			}
 		}
 		l.cursor = response.Cursor
+		l.internalExt = string(response.InternalExt)
+		l.syncClock(response.Now)
+		received := time.Now()
 
 		if l.t.Debug {
 			l.t.debugHandler(fmt.Sprintf("Got %d messages, %s", len(response.Messages), response.Cursor))
 		}
 
 		for _, rawMsg := range response.Messages {
+			if l.dedup.duplicate(rawMsg.MsgId) {
+				continue
+			}
 			msg, err := parseMsg(rawMsg, l.t.warnHandler, l.t.debugHandler, l.t.enableExperimentalEvents)
 			if err != nil {
 				return fmt.Errorf("Failed to parse response message: %w", err)
 			}
+			if !l.replay && !rawMsg.IsHistory {
+				l.measureLatency(messageCreateTime(rawMsg.Payload), received, response.Now)
+			}
 			if msg != nil {
-				// TODO, let's fix this
-				// If channel is full, discard the first message
-				if len(l.Events) == l.chanSize {
-					<-l.Events
+				for _, e := range l.track(msg) {
+					l.sendEvent(e)
 				}
-				l.Events <- msg
 			}
 
 			// If livestream has ended
@@ -240,24 +376,26 @@ func (l *Live) parseWssMsg(wssMsg []byte) error {
 	return nil
 }
 
-func (l *Live) sendPing() {
+func (l *Live) sendPing(stop <-chan struct{}) {
 	const helloHex = "3a026862"
 	b, err := hex.DecodeString(helloHex)
 	if err != nil {
 		l.t.errHandler(err)
 	}
 
-	t := time.NewTicker(10000 * time.Millisecond)
+	t := time.NewTicker(l.t.pingInterval)
 	defer t.Stop()
 
 	for {
 		select {
+		case <-stop:
+			return
 		case <-l.done():
 			return
 		case <-l.t.done():
 			return
 		case <-t.C:
-			if err := wsutil.WriteClientBinary(l.wss, b); err != nil {
+			if err := wsutil.WriteClientBinary(l.conn(), b); err != nil {
 				l.t.errHandler(fmt.Errorf("Failed to send ping: %w", err))
 			} else {
 				if l.t.enableWSTrace {
@@ -280,7 +418,7 @@ func (l *Live) sendAck(id uint64, extra []byte) error {
 		return err
 	}
 
-	if err := wsutil.WriteClientBinary(l.wss, b); err != nil {
+	if err := wsutil.WriteClientBinary(l.conn(), b); err != nil {
 		return err
 	}
 	if l.t.enableWSTrace {
@@ -296,8 +434,9 @@ func (l *Live) tryConnectionUpgrade() error {
 	if l.wsParams == nil {
 		return fmt.Errorf("cannot upgrade connection without a wsURL")
 	}
-	err := l.connect(l.wsURL, l.wsParams)
+	err := l.connect(l.ctx, l.wsURL, l.wsParams)
 	if err != nil {
+		l.stopTrackers()
 		close(l.Events)
 		return fmt.Errorf("Connection upgrade failed: %w", err)
 	}
@@ -305,15 +444,11 @@ func (l *Live) tryConnectionUpgrade() error {
 		l.t.debugHandler("Connected to websocket")
 	}
 
-	l.wg.Add(2)
+	l.wg.Add(1)
 	go func() {
 		defer l.wg.Done()
 		defer close(l.Events)
-		l.readSocket()
-	}()
-	go func() {
-		defer l.wg.Done()
-		l.sendPing()
+		l.run()
 	}()
 
 	l.t.infoHandler("Connected to websocket")
@@ -3,13 +3,13 @@
 package gotiktoklive
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/steampoweredtaco/gotiktoklive/test_types"
-	"golang.org/x/net/context"
 )
 
 func TestWebsocket(t *testing.T) {
@@ -21,7 +21,7 @@ func TestWebsocket(t *testing.T) {
 	tiktok.debugHandler = func(i ...interface{}) {
 		t.Log(i...)
 	}
-	id, err := tiktok.getRoomID(test_types.USERNAME)
+	id, err := tiktok.getRoomID(context.Background(), test_types.USERNAME)
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -40,7 +40,7 @@ func TestWebsocket(t *testing.T) {
 		close(live.Events)
 	}
 
-	err = live.getRoomData()
+	err = live.getRoomData(ctx)
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -50,13 +50,13 @@ func TestWebsocket(t *testing.T) {
 	}
 	t.Logf("Ws url: %s, %+v", live.wsURL, live.wsParams)
 
-	if err := live.connect(live.wsURL, live.wsParams); err != nil {
+	if err := live.connect(ctx, live.wsURL, live.wsParams); err != nil {
 		t.Fatal(err)
 	}
 
 	tiktok.wg.Add(2)
 	go live.readSocket()
-	go live.sendPing()
+	go live.sendPing(ctx.Done())
 
 	timeout := time.After(5 * time.Second)
 	for {